@@ -83,3 +83,100 @@ func (c *Client) UpdateAgentSpaceAssociation(agent string, ns string, sa string,
 
 	return nil
 }
+
+func (c *Client) CreateAgent(name string, agent_type string, description string) (*AgentRegistration, error) {
+	data := AgentRequest{
+		Name:        name,
+		Type:        agent_type,
+		Description: description,
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall agent: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/executionhosts", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := AgentRegistration{}
+	err = json.Unmarshal(body, &registration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+func (c *Client) GetAgent(name string) (*Agent, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/executionhosts/%s", c.HostURL, name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	agent := Agent{}
+	err = json.Unmarshal(body, &agent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &agent, nil
+}
+
+func (c *Client) ListAgents() ([]Agent, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/executionhosts", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	agents := []Agent{}
+	err = json.Unmarshal(body, &agents)
+	if err != nil {
+		return nil, err
+	}
+
+	return agents, nil
+}
+
+func (c *Client) DeleteAgent(name string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/executionhosts/%s", c.HostURL, name), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
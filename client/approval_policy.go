@@ -0,0 +1,92 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+func (c *Client) CreateApprovalPolicy(policy ApprovalPolicy) error {
+	payload, err := json.Marshal(policy)
+	if err != nil {
+		log.Fatalf("impossible to marshall create approval policy request: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/approval/policies", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetApprovalPolicy(name string) (*ApprovalPolicy, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/approval/policies/%s", c.HostURL, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	approval_policy := ApprovalPolicy{}
+
+	err = json.Unmarshal(body, &approval_policy)
+	if err != nil {
+		return nil, err
+	}
+	return &approval_policy, nil
+}
+
+func (c *Client) UpdateApprovalPolicy(policy ApprovalPolicy) error {
+	payload, err := json.Marshal(policy)
+	if err != nil {
+		log.Fatalf("impossible to marshall update approval policy request: %s", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/approval/policies/%s", c.HostURL, policy.Name), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteApprovalPolicy(name string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/approval/policies/%s", c.HostURL, name), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetApprovalRequests(space_name string, approval_channel string, status string, requester string) ([]ApprovalRequest, error) {
+	u, err := url.Parse(fmt.Sprintf("%sapi/approval/requests", c.HostURL))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	if space_name != "" {
+		q.Add("space_name", space_name)
+	}
+	if approval_channel != "" {
+		q.Add("approval_channel", approval_channel)
+	}
+	if status != "" {
+		q.Add("status", status)
+	}
+	if requester != "" {
+		q.Add("requester", requester)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []ApprovalRequest{}
+	err = json.Unmarshal(body, &requests)
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (c *Client) ApproveApprovalRequest(id string, comment string) error {
+	return c.decideApprovalRequest(id, "approve", comment)
+}
+
+func (c *Client) DenyApprovalRequest(id string, comment string) error {
+	return c.decideApprovalRequest(id, "deny", comment)
+}
+
+func (c *Client) decideApprovalRequest(id string, decision string, comment string) error {
+	data := ApprovalRequestDecision{
+		Comment: comment,
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall approval request decision: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/approval/requests/%s/%s", c.HostURL, id, decision), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
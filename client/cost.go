@@ -0,0 +1,57 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetEnvironmentCost(spaceName string, environmentId string) (*CostReport, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/spaces/%s/environments/%s/cost", c.HostURL, spaceName, environmentId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := CostReport{}
+	err = json.Unmarshal(body, &cost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cost, nil
+}
+
+func (c *Client) GetSpaceCost(spaceName string, from string, to string) (*CostReport, error) {
+	params := url.Values{}
+	params.Add("from", from)
+	params.Add("to", to)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/spaces/%s/analytics/cost?%s", c.HostURL, spaceName, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := CostReport{}
+	err = json.Unmarshal(body, &cost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cost, nil
+}
@@ -8,18 +8,16 @@ import (
 	"net/http"
 )
 
-func (c *Client) AddAWSCostTarget(name string, target_type string, role_arn string, external_id string) error {
-
-	data := AwsCostTarget{
-		Name:       name,
-		Type:       target_type,
-		ARN:        role_arn,
-		ExternalId: external_id,
-	}
+const (
+	CostTargetTypeAws   = "aws"
+	CostTargetTypeAzure = "azure"
+	CostTargetTypeGcp   = "gcp"
+)
 
-	payload, err := json.Marshal(data)
+func (c *Client) AddCostTarget(target CostTarget) error {
+	payload, err := json.Marshal(target)
 	if err != nil {
-		log.Fatalf("impossible to marshall aws cost target request: %s", err)
+		log.Fatalf("impossible to marshall %s cost target request: %s", target.Type, err)
 	}
 
 	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/settings/costtargets", c.HostURL), bytes.NewReader(payload))
@@ -38,6 +36,52 @@ func (c *Client) AddAWSCostTarget(name string, target_type string, role_arn stri
 	return nil
 }
 
+func (c *Client) GetCostTarget(target_name string) (*CostTarget, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/settings/costtargets/%s", c.HostURL, target_name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	target := CostTarget{}
+	err = json.Unmarshal(body, &target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &target, nil
+}
+
+func (c *Client) ListCostTargets() ([]CostTarget, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/settings/costtargets", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []CostTarget{}
+	err = json.Unmarshal(body, &targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
 func (c *Client) DeleteCostTarget(target_name string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/settings/costtargets/%s", c.HostURL, target_name), nil)
 	if err != nil {
@@ -55,16 +99,10 @@ func (c *Client) DeleteCostTarget(target_name string) error {
 	return nil
 }
 
-func (c *Client) UpdateAWSCostTarget(target_name string, new_target_name string, target_type string, role_arn string, external_id string) error {
-	data := AwsCostTarget{
-		NewName:    new_target_name,
-		Type:       target_type,
-		ARN:        role_arn,
-		ExternalId: external_id,
-	}
-	payload, err := json.Marshal(data)
+func (c *Client) UpdateCostTarget(target_name string, target CostTarget) error {
+	payload, err := json.Marshal(target)
 	if err != nil {
-		log.Fatalf("impossible to marshall target name update request: %s", err)
+		log.Fatalf("impossible to marshall %s cost target update request: %s", target.Type, err)
 	}
 
 	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/settings/costtargets/%s", c.HostURL, target_name), bytes.NewReader(payload))
@@ -58,6 +58,11 @@ func (c *Client) CreateAccountCredentials(name string, description string, cloud
 		AllowedSpaceNames: allowed_space_names,
 		AllSpacesAllowed:  len(allowed_space_names) == 0 || allowed_space_names == nil,
 	}
+	return c.CreateCloudAccountCredentials(credentials)
+}
+
+// CreateCloudAccountCredentials stores account level credentials of any of the supported cloud types.
+func (c *Client) CreateCloudAccountCredentials(credentials AccountCredentials) error {
 	payload, err := json.Marshal(credentials)
 	if err != nil {
 		log.Fatalf("impossible to marshall credentials: %s", err)
@@ -223,11 +228,16 @@ func (c *Client) UpdateAccountCredentials(name string, description string, cloud
 		CredentialData:    credential_data,
 		AllowedSpaceNames: allowed_space_names,
 	}
+	return c.UpdateCloudAccountCredentials(credentials)
+}
+
+// UpdateCloudAccountCredentials updates account level credentials of any of the supported cloud types.
+func (c *Client) UpdateCloudAccountCredentials(credentials AccountCredentials) error {
 	payload, err := json.Marshal(credentials)
 	if err != nil {
 		log.Fatalf("impossible to marshall credentials: %s", err)
 	}
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/settings/credentialstore/%s", c.HostURL, name), bytes.NewReader(payload))
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/settings/credentialstore/%s", c.HostURL, credentials.Name), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
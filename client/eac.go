@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) RegisterEacFile(space_name string, repository_name string, path string) error {
+	data := EacFileRequest{
+		RepositoryName: repository_name,
+		Path:           path,
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall eac file: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/eac", c.HostURL, space_name), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetEacFile(space_name string, repository_name string, path string) (*EacFile, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/spaces/%s/eac", c.HostURL, space_name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []EacFile{}
+	err = json.Unmarshal(body, &files)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.RepositoryName == repository_name && file.Path == path {
+			return &file, nil
+		}
+	}
+	return nil, fmt.Errorf("eac file %s in repository %s not found", path, repository_name)
+}
+
+func (c *Client) DeregisterEacFile(space_name string, repository_name string, path string) error {
+	query := url.Values{}
+	query.Set("repository_name", repository_name)
+	query.Set("path", path)
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/spaces/%s/eac?%s", c.HostURL, space_name, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
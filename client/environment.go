@@ -6,8 +6,12 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
+const environmentsPageSize = 100
+
 func (c *Client) GetEnvironmentDetails(spaceName string, environmentId string) (*Environment, string, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/spaces/%s/environments/%s", c.HostURL, spaceName, environmentId), nil)
 	if err != nil {
@@ -70,6 +74,28 @@ func (c *Client) CreateEnvironment(Space string, BlueprintName string, Environme
 	return body, nil
 }
 
+func (c *Client) CreateEnvironmentFromSource(Space string, Environment EnvironmentFromSourceRequest) ([]byte, error) {
+	payload, err := json.Marshal(Environment)
+	if err != nil {
+		log.Fatalf("impossible to marshall Environment: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/environments/from_source", c.HostURL, Space), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
 func (c *Client) UpdateEnvironmentName(Space string, Id string, Name string) error {
 	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/update_v2/%s/rename", c.HostURL, Space, Id, Name), nil)
 	if err != nil {
@@ -87,6 +113,81 @@ func (c *Client) UpdateEnvironmentName(Space string, Id string, Name string) err
 	return nil
 }
 
+func (c *Client) UpdateEnvironmentGrains(Space string, Id string, Grains []string) error {
+	data := EnvironmentGrainsUpdateRequest{
+		Grains: Grains,
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall environment grains update request: %s", err)
+	}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/update_v2/grains", c.HostURL, Space, Id), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateEnvironmentTags(Space string, Id string, Tags map[string]string) error {
+	data := EnvironmentTagsUpdateRequest{
+		Tags: []NameValuePair{},
+	}
+	for name, value := range Tags {
+		data.Tags = append(data.Tags, NameValuePair{Name: name, Value: value})
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall environment tags update request: %s", err)
+	}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/tags", c.HostURL, Space, Id), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateEnvironmentOwner(Space string, Id string, OwnerEmail string) error {
+	data := EnvironmentOwnerUpdateRequest{
+		OwnerEmail: OwnerEmail,
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall environment owner update request: %s", err)
+	}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/owner", c.HostURL, Space, Id), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Client) UpdateEnvironmentCollaborators(Space string, Id string, CollaboratorsEmails []string, AllSpaceMembers bool) error {
 	collaborators := Collaborators{
 		Collaborators:   CollaboratorsEmails,
@@ -145,3 +246,107 @@ func (c *Client) ForceTerminateEnvironment(Space string, Id string) error {
 
 	return nil
 }
+
+func (c *Client) GetEnvironments(spaceName string, ownerEmail string, blueprintName string, showEnded bool) ([]Environment, error) {
+	environments := []Environment{}
+	for offset := 0; ; offset += environmentsPageSize {
+		u, err := url.Parse(fmt.Sprintf("%sapi/spaces/%s/environments", c.HostURL, spaceName))
+		if err != nil {
+			return nil, err
+		}
+		q := u.Query()
+		q.Add("count", strconv.Itoa(environmentsPageSize))
+		q.Add("offset", strconv.Itoa(offset))
+		q.Add("show_ended", strconv.FormatBool(showEnded))
+		if ownerEmail != "" {
+			q.Add("owner_email", ownerEmail)
+		}
+		if blueprintName != "" {
+			q.Add("blueprint_name", blueprintName)
+		}
+		u.RawQuery = q.Encode()
+
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Accept", "application/json")
+
+		body, err := c.doRequest(req, &c.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		page := EnvironmentList{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, page.Environments...)
+		if len(page.Environments) < environmentsPageSize {
+			break
+		}
+	}
+
+	return environments, nil
+}
+
+func (c *Client) ExtendEnvironment(Space string, Id string, Duration string) error {
+	data := EnvironmentExtendRequest{
+		Duration: Duration,
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall environment extend request: %s", err)
+	}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/extend", c.HostURL, Space, Id), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ReleaseEnvironment(Space string, Id string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/environments/%s/release", c.HostURL, Space, Id), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ReconcileEnvironment(Space string, Id string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/environments/%s/reconcile", c.HostURL, Space, Id), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
+	"net/url"
 )
 
 func (c *Client) GetIntrospectionDetails(spaceName string, environmentId string) ([]IntrospectionItem, error) {
@@ -28,3 +31,45 @@ func (c *Client) GetIntrospectionDetails(spaceName string, environmentId string)
 	// Return the introspection details
 	return introspection, nil
 }
+
+func (c *Client) PublishIntrospectionResource(spaceName string, environmentId string, resource CustomIntrospectionResource) error {
+	payload, err := json.Marshal(resource)
+	if err != nil {
+		log.Fatalf("impossible to marshall introspection resource: %s", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/environments/%s/introspection/custom_resources", c.HostURL, spaceName, environmentId), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteIntrospectionResource(spaceName string, environmentId string, grainPath string, name string) error {
+	params := url.Values{}
+	params.Add("grain_path", grainPath)
+	params.Add("name", name)
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/spaces/%s/environments/%s/introspection/custom_resources?%s", c.HostURL, spaceName, environmentId, params.Encode()), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -33,10 +33,14 @@ type Blueprint struct {
 }
 
 type Input struct {
-	Name           string   `json:"name"`
-	PossibleValues []string `json:"possible_values"`
-	DefaultValue   string   `json:"default_value"`
-	Description    string   `json:"description"`
+	Name            string   `json:"name"`
+	PossibleValues  []string `json:"possible_values"`
+	DefaultValue    string   `json:"default_value"`
+	HasDefaultValue bool     `json:"has_default_value"`
+	Description     string   `json:"description"`
+	DefaultOverride *string  `json:"default_value_override"`
+	Locked          bool     `json:"locked"`
+	Hidden          bool     `json:"hidden"`
 }
 
 type BlueprintTag struct {
@@ -74,6 +78,28 @@ type AgentSpaceAssociation struct {
 	DefaultServiceAccount string `json:"service_account"`
 }
 
+type AgentRequest struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type AgentRegistration struct {
+	Name            string `json:"name"`
+	Token           string `json:"token"`
+	InstallManifest string `json:"install_manifest"`
+}
+
+type Agent struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	Version     string   `json:"version"`
+	LastSeen    string   `json:"last_seen"`
+	Spaces      []string `json:"spaces"`
+}
+
 type RepoSpaceAssociation struct {
 	URL             string  `json:"repository_url"`
 	AccessToken     *string `json:"access_token"`
@@ -93,6 +119,16 @@ type RepoSpaceAssociationWithCredentials struct {
 	AutoRegisterEac bool    `json:"eac_auto_registration"`
 }
 
+type RepoSpaceAssociationWithConnection struct {
+	URL                     string  `json:"repository_url"`
+	Type                    string  `json:"repository_type"`
+	Branch                  string  `json:"branch"`
+	Name                    string  `json:"repository_name"`
+	GithubAppInstallationId *string `json:"github_app_installation_id,omitempty"`
+	OAuthConnectionName     *string `json:"oauth_connection_name,omitempty"`
+	AutoRegisterEac         bool    `json:"eac_auto_registration"`
+}
+
 type GitlabEnterpriseRepoSpaceAssociation struct {
 	Name            string   `json:"repository_name"`
 	URL             string   `json:"repository_url"`
@@ -128,6 +164,22 @@ type CodeCommitRepoSpaceAssociation struct {
 	AutoRegisterEac bool   `json:"eac_auto_registration"`
 }
 
+type RepositoryOnboarding struct {
+	Name            string   `json:"repository_name"`
+	URL             string   `json:"repository_url"`
+	Type            string   `json:"repository_type"`
+	Branch          string   `json:"branch"`
+	CredentialName  string   `json:"credential_name,omitempty"`
+	UseAllAgents    *bool    `json:"use_all_agents,omitempty"`
+	Agents          []string `json:"agents,omitempty"`
+	RoleArn         string   `json:"role_arn,omitempty"`
+	Region          string   `json:"region,omitempty"`
+	ExternalId      string   `json:"external_id,omitempty"`
+	Username        string   `json:"username,omitempty"`
+	Password        string   `json:"password,omitempty"`
+	AutoRegisterEac bool     `json:"eac_auto_registration"`
+}
+
 type KeyValuePair struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -154,6 +206,19 @@ type CatalogItemRequest struct {
 	RepositoryName string `json:"repository_name"`
 }
 
+type CatalogItemInputsRequest struct {
+	BlueprintName  string             `json:"blueprint_name"`
+	RepositoryName string             `json:"repository_name"`
+	Inputs         []CatalogItemInput `json:"inputs"`
+}
+
+type CatalogItemInput struct {
+	Name            string  `json:"name"`
+	DefaultOverride *string `json:"default_value_override,omitempty"`
+	Locked          bool    `json:"locked"`
+	Hidden          bool    `json:"hidden"`
+}
+
 type ParameterRequest struct {
 	Name        string `json:"name"`
 	Value       string `json:"value"`
@@ -175,12 +240,37 @@ type SpaceRole struct {
 	SpaceRole string `json:"space_role"`
 }
 
-type AwsCostTarget struct {
-	Name       string `json:"name"`
-	NewName    string `json:"new_name"`
-	Type       string `json:"type"`
-	ARN        string `json:"role_arn"`
-	ExternalId string `json:"external_id"`
+// CostTarget is a cost collection target of any of the supported clouds. Only the details
+// of the cloud matching the target type are set.
+type CostTarget struct {
+	Name    string `json:"name"`
+	NewName string `json:"new_name,omitempty"`
+	Type    string `json:"type"`
+	AwsCostTargetDetails
+	AzureCostTargetDetails
+	GcpCostTargetDetails
+}
+
+type AwsCostTargetDetails struct {
+	ARN        string `json:"role_arn,omitempty"`
+	ExternalId string `json:"external_id,omitempty"`
+}
+
+type AzureCostTargetDetails struct {
+	TenantId         string `json:"tenant_id,omitempty"`
+	SubscriptionId   string `json:"subscription_id,omitempty"`
+	ClientId         string `json:"client_id,omitempty"`
+	ClientSecret     string `json:"client_secret,omitempty"`
+	StorageAccount   string `json:"storage_account,omitempty"`
+	StorageContainer string `json:"storage_container,omitempty"`
+	ExportDirectory  string `json:"export_directory,omitempty"`
+}
+
+type GcpCostTargetDetails struct {
+	ProjectId         string `json:"project_id,omitempty"`
+	BillingDataset    string `json:"billing_dataset,omitempty"`
+	BillingTable      string `json:"billing_table,omitempty"`
+	ServiceAccountKey string `json:"service_account_key,omitempty"`
 }
 
 type SubscriptionsRequest struct {
@@ -226,6 +316,17 @@ type Environment struct {
 	Owner             EnvironmentOwner             `json:"owner"`
 	Initiator         EnvironmentInitiator         `json:"initiator"`
 	CollaboratorsInfo EnvironmentCollaboratorsInfo `json:"collaborators_info"`
+	Cost              *EnvironmentCost             `json:"cost"`
+}
+
+type EnvironmentList struct {
+	Environments []Environment `json:"environment_list"`
+}
+
+type EnvironmentCost struct {
+	Sum        float64 `json:"sum"`
+	LastUpdate string  `json:"last_update"`
+	Final      bool    `json:"final"`
 }
 
 type EnvironmentDetails struct {
@@ -318,16 +419,48 @@ type GrainSource struct {
 }
 
 type IntrospectionItem struct {
-	GrainPath        string          `json:"grain_path"`
-	GrainType        string          `json:"grain_type"`
-	ResourceName     string          `json:"resource_name"`
-	ResourceType     string          `json:"resource_type"`
-	ResourceCategory string          `json:"resource_category"`
-	Status           string          `json:"status"`
-	Alias            string          `json:"alias"`
-	HasRunningAction bool            `json:"has_running_action"`
-	Attributes       []NameValuePair `json:"attributes"`
-	CustomIcon       string          `json:"custom_icon"`
+	GrainPath        string                    `json:"grain_path"`
+	GrainType        string                    `json:"grain_type"`
+	ResourceName     string                    `json:"resource_name"`
+	ResourceType     string                    `json:"resource_type"`
+	ResourceCategory string                    `json:"resource_category"`
+	Status           string                    `json:"status"`
+	Alias            string                    `json:"alias"`
+	HasRunningAction bool                      `json:"has_running_action"`
+	Attributes       []NameValuePair           `json:"attributes"`
+	CustomIcon       string                    `json:"custom_icon"`
+	Links            []IntrospectionLinkButton `json:"links"`
+}
+
+type CustomIntrospectionResource struct {
+	GrainPath  string                    `json:"grain_path"`
+	Name       string                    `json:"name"`
+	Image      string                    `json:"image,omitempty"`
+	Attributes []NameValuePair           `json:"attributes"`
+	Links      []IntrospectionLinkButton `json:"links"`
+}
+
+type IntrospectionLinkButton struct {
+	Icon  string `json:"icon"`
+	Href  string `json:"href"`
+	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
+type CostReport struct {
+	Total     float64        `json:"total"`
+	Currency  string         `json:"currency"`
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Resources []ResourceCost `json:"resources"`
+}
+
+type ResourceCost struct {
+	Name          string  `json:"name"`
+	Type          string  `json:"type"`
+	GrainPath     string  `json:"grain_path"`
+	EnvironmentId string  `json:"environment_id"`
+	Cost          float64 `json:"cost"`
 }
 
 type Workflow struct {
@@ -345,6 +478,31 @@ type EnvironmentWorkflow struct {
 	InputsOverrides map[string]string `json:"inputs_overrides"`
 }
 
+// Grains are updated to the latest commit of their source.
+type EnvironmentGrainsUpdateRequest struct {
+	Grains []string `json:"grains"`
+}
+
+type EnvironmentTagsUpdateRequest struct {
+	Tags []NameValuePair `json:"tags"`
+}
+
+type EnvironmentOwnerUpdateRequest struct {
+	OwnerEmail string `json:"owner_email"`
+}
+
+type EnvironmentExtendRequest struct {
+	Duration string `json:"duration"`
+}
+
+type EnvironmentWorkflowRunRequest struct {
+	Inputs map[string]string `json:"inputs"`
+}
+
+type EnvironmentWorkflowRun struct {
+	Id string `json:"id"`
+}
+
 type Schedule struct {
 	Scheduler  string `json:"scheduler"`
 	Overridden bool   `json:"overridden"`
@@ -407,10 +565,17 @@ type AccountCredentials struct {
 }
 
 type CredentialData struct {
-	Token  *string `json:"token,omitempty"`
-	Key    *string `json:"key,omitempty"`
-	Secret *string `json:"secret,omitempty"`
-	Type   string  `json:"type"`
+	Token        *string `json:"token,omitempty"`
+	Key          *string `json:"key,omitempty"`
+	Secret       *string `json:"secret,omitempty"`
+	TenantId     *string `json:"tenant_id,omitempty"`
+	ClientId     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+	RoleArn      *string `json:"role_arn,omitempty"`
+	ExternalId   *string `json:"external_id,omitempty"`
+	ServiceKey   *string `json:"service_account_key,omitempty"`
+	Kubeconfig   *string `json:"kubeconfig,omitempty"`
+	Type         string  `json:"type"`
 }
 
 type BlueprintSource struct {
@@ -436,6 +601,20 @@ type EnvironmentRequest struct {
 	Workflows        []EnvironmentWorkflow `json:"workflows"`
 }
 
+// EnvironmentFromSourceRequest launches an environment from a blueprint that is not
+// onboarded to the space, either provided inline or fetched from a git repository.
+type EnvironmentFromSourceRequest struct {
+	EnvironmentRequest
+	BlueprintYaml string                `json:"blueprint_yaml,omitempty"`
+	Source        *EnvironmentGitSource `json:"source,omitempty"`
+}
+
+type EnvironmentGitSource struct {
+	RepositoryUrl string `json:"repository_url"`
+	Ref           string `json:"ref,omitempty"`
+	Path          string `json:"path,omitempty"`
+}
+
 type WorkflowRequest struct {
 	BlueprintName  string `json:"blueprint_name"`
 	RepositoryName string `json:"repository_name"`
@@ -498,11 +677,15 @@ type ContentFormat struct {
 type ResourceInventory struct {
 	Credentials string                   `json:"credentials"`
 	Details     ResourceInventoryDetails `json:"details"`
+	Status      string                   `json:"status,omitempty"`
 }
 
+// ResourceInventoryDetails holds the cloud specific configuration of a resource inventory.
+// AWS inventories are read through a Resource Explorer view, Azure inventories through Resource Graph.
 type ResourceInventoryDetails struct {
-	Type    string  `json:"type"`
-	ViewArn *string `json:"view_arn"`
+	Type            string   `json:"type"`
+	ViewArn         *string  `json:"view_arn,omitempty"`
+	SubscriptionIds []string `json:"subscription_ids,omitempty"`
 }
 
 type DeploymentEngine struct {
@@ -558,6 +741,42 @@ type Approver struct {
 	UserEmail string `json:"user_email"`
 }
 
+type ApprovalPolicy struct {
+	Name            string                    `json:"name"`
+	Description     string                    `json:"description"`
+	ApprovalChannel string                    `json:"approval_channel"`
+	SpaceName       string                    `json:"space_name"`
+	BlueprintName   *string                   `json:"blueprint_name,omitempty"`
+	RepositoryName  *string                   `json:"repository_name,omitempty"`
+	Conditions      *ApprovalPolicyConditions `json:"conditions,omitempty"`
+}
+
+type ApprovalPolicyConditions struct {
+	DurationAbove *string                        `json:"duration_above,omitempty"`
+	CostAbove     *float64                       `json:"cost_above,omitempty"`
+	Inputs        []ApprovalPolicyInputCondition `json:"inputs,omitempty"`
+}
+
+type ApprovalPolicyInputCondition struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type ApprovalRequest struct {
+	Id              string `json:"id"`
+	SpaceName       string `json:"space_name"`
+	ApprovalChannel string `json:"approval_channel"`
+	Status          string `json:"status"`
+	Requester       string `json:"requester"`
+	EnvironmentId   string `json:"environment_id"`
+	BlueprintName   string `json:"blueprint_name"`
+	CreatedDate     string `json:"created_date"`
+}
+
+type ApprovalRequestDecision struct {
+	Comment string `json:"comment"`
+}
+
 type Audit struct {
 	Type       string           `json:"type"`
 	Properties *AuditProperties `json:"properties,omitempty"`
@@ -577,16 +796,47 @@ type RepoUpdate struct {
 }
 
 type RepoDetails struct {
-	Name           string   `json:"name"`
-	URL            string   `json:"repository_url"`
-	Token          *string  `json:"token"`
-	Branch         string   `json:"branch"`
-	CredentialName string   `json:"credential_name"`
-	UseAllAgents   bool     `json:"use_all_agents"`
-	Agents         []Agents `json:"agents"`
-	Status         string   `json:"status"`
-	SpaceName      string   `json:"space_name"`
-	Errors         []Error  `json:"errors"`
+	Name             string                `json:"name"`
+	URL              string                `json:"repository_url"`
+	Type             string                `json:"repository_type"`
+	Token            *string               `json:"token"`
+	Branch           string                `json:"branch"`
+	CredentialName   string                `json:"credential_name"`
+	UseAllAgents     bool                  `json:"use_all_agents"`
+	Agents           []Agents              `json:"agents"`
+	Status           string                `json:"status"`
+	SpaceName        string                `json:"space_name"`
+	Errors           []Error               `json:"errors"`
+	LastSyncedCommit string                `json:"last_synced_commit"`
+	AutoRegisterEac  bool                  `json:"eac_auto_registration"`
+	DiscoveryFolders []RepoDiscoveryFolder `json:"discovery_folders"`
+	BlueprintsCount  int64                 `json:"blueprints_count"`
+}
+
+type RepoDiscoveryFolder struct {
+	Path    string   `json:"path"`
+	Type    string   `json:"type"`
+	Branch  string   `json:"branch,omitempty"`
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+type RepoDiscoverySettings struct {
+	AutoRegisterEac bool                  `json:"eac_auto_registration"`
+	Folders         []RepoDiscoveryFolder `json:"folders"`
+}
+
+type EacFileRequest struct {
+	RepositoryName string `json:"repository_name"`
+	Path           string `json:"path"`
+}
+
+type EacFile struct {
+	RepositoryName string  `json:"repository_name"`
+	Path           string  `json:"path"`
+	EnvironmentId  string  `json:"environment_id"`
+	Status         string  `json:"status"`
+	Errors         []Error `json:"errors"`
 }
 
 type Agents struct {
@@ -9,18 +9,19 @@ import (
 )
 
 func (c *Client) OnboardCodeCommitRepoToSpace(space_name string, repository_name string, role_arn string, repository_url string, aws_region string,
-	repository_branch string, external_id string, git_username string, git_password string, credential_name string) error {
+	repository_branch string, external_id string, git_username string, git_password string, credential_name string, auto_register_eac bool) error {
 
 	data := CodeCommitRepoSpaceAssociation{
-		URL:            repository_url,
-		RoleArn:        role_arn,
-		Region:         aws_region,
-		Branch:         repository_branch,
-		Name:           repository_name,
-		ExternalId:     external_id,
-		Username:       git_username,
-		Password:       git_password,
-		CredentialName: credential_name,
+		URL:             repository_url,
+		RoleArn:         role_arn,
+		Region:          aws_region,
+		Branch:          repository_branch,
+		Name:            repository_name,
+		ExternalId:      external_id,
+		Username:        git_username,
+		Password:        git_password,
+		CredentialName:  credential_name,
+		AutoRegisterEac: auto_register_eac,
 	}
 
 	payload, err := json.Marshal(data)
@@ -111,26 +112,68 @@ func (c *Client) OnboardAdoServerRepoToSpace(space_name string, repository_name
 	return nil
 }
 
-func (c *Client) OnboardRepoToSpace(space_name string, repo_name string, repo_type string, repo_url string, repo_token *string, repo_branch string, credential_name *string) error {
+// RepositoryOnboardingPaths maps each repository type to its onboarding endpoint.
+var RepositoryOnboardingPaths = map[string]string{
+	"github":            "github",
+	"bitbucket":         "bitbucket",
+	"gitlab":            "gitlab",
+	"azure":             "azure",
+	"codecommit":        "codeCommit",
+	"gitlab_enterprise": "gitlabEnterprise",
+	"ado_server":        "azureEnterprise",
+	"github_enterprise": "githubEnterprise",
+	"bitbucket_server":  "bitbucketServer",
+}
+
+func (c *Client) OnboardRepositoryToSpace(space_name string, repository RepositoryOnboarding) error {
+	repo_path, ok := RepositoryOnboardingPaths[repository.Type]
+	if !ok {
+		return fmt.Errorf("unsupported repository type %s", repository.Type)
+	}
+
+	payload, err := json.Marshal(repository)
+	if err != nil {
+		log.Fatalf("impossible to marshall repo association: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/repositories/%s", c.HostURL, space_name, repo_path), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) OnboardRepoToSpace(space_name string, repo_name string, repo_type string, repo_url string, repo_token *string, repo_branch string, credential_name *string, auto_register_eac bool) error {
 	var data interface{}
 	var url string
 	if credential_name == nil || *credential_name == "" {
 		data = RepoSpaceAssociation{
-			URL:         repo_url,
-			AccessToken: repo_token,
-			Type:        repo_type,
-			Branch:      repo_branch,
-			Name:        repo_name,
+			URL:             repo_url,
+			AccessToken:     repo_token,
+			Type:            repo_type,
+			Branch:          repo_branch,
+			Name:            repo_name,
+			AutoRegisterEac: auto_register_eac,
 		}
 		url = fmt.Sprintf("%sapi/spaces/%s/repositories", c.HostURL, space_name)
 
 	} else {
 		data = RepoSpaceAssociationWithCredentials{
-			URL:            repo_url,
-			Type:           repo_type,
-			Branch:         repo_branch,
-			Name:           repo_name,
-			CredentialName: credential_name,
+			URL:             repo_url,
+			Type:            repo_type,
+			Branch:          repo_branch,
+			Name:            repo_name,
+			CredentialName:  credential_name,
+			AutoRegisterEac: auto_register_eac,
 		}
 		url = fmt.Sprintf("%sapi/spaces/%s/repositories/%s", c.HostURL, space_name, repo_type)
 	}
@@ -155,6 +198,37 @@ func (c *Client) OnboardRepoToSpace(space_name string, repo_name string, repo_ty
 	return nil
 }
 
+func (c *Client) OnboardRepoToSpaceWithConnection(space_name string, repo_name string, repo_type string, repo_url string, repo_branch string, github_app_installation_id *string, oauth_connection_name *string, auto_register_eac bool) error {
+	data := RepoSpaceAssociationWithConnection{
+		URL:                     repo_url,
+		Type:                    repo_type,
+		Branch:                  repo_branch,
+		Name:                    repo_name,
+		GithubAppInstallationId: github_app_installation_id,
+		OAuthConnectionName:     oauth_connection_name,
+		AutoRegisterEac:         auto_register_eac,
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall repo association: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/repositories/%s/connection", c.HostURL, space_name, repo_type), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Client) RemoveRepoFromSpace(space_name string, repo_name string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%sapi/spaces/%s/repositories?repository_name=%s", c.HostURL, space_name, repo_name), nil)
 	if err != nil {
@@ -224,6 +298,49 @@ func (c *Client) UpdateRepoConfiguration(space_name string, repo_name string, cr
 	return nil
 }
 
+func (c *Client) UpdateRepoDiscovery(space_name string, repo_name string, auto_register_eac bool, folders []RepoDiscoveryFolder) error {
+	data := RepoDiscoverySettings{
+		AutoRegisterEac: auto_register_eac,
+		Folders:         folders,
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall repo discovery settings: %s", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%sapi/spaces/%s/repositories/%s/discovery", c.HostURL, space_name, repo_name), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) SyncRepo(space_name string, repo_name string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/repositories/%s/sync", c.HostURL, space_name, repo_name), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	_, err = c.doRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Client) GetRepoDetails(space_name string, repo_name string) (*RepoDetails, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/spaces/%s/repositories", c.HostURL, space_name), nil)
 	if err != nil {
@@ -35,31 +35,50 @@ func (c *Client) ConfigureResourveInventory(credentials string, details Resource
 	return nil
 }
 
-func (c *Client) GetResourceInventory(credentials string, details ResourceInventoryDetails) error {
-	data := ResourceInventory{
-		Credentials: credentials,
-		Details:     details,
+func (c *Client) GetResourceInventory(credentials string) (*ResourceInventory, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/cloudresource/configuration?credentials=%s", c.HostURL, credentials), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	payload, err := json.Marshal(data)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
 	if err != nil {
-		log.Fatalf("impossible to marshall Resource Inventory: %s", err)
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/cloudresource/configuration?credentials=%s", c.HostURL, credentials), bytes.NewReader(payload))
+	inventory := ResourceInventory{}
+	err = json.Unmarshal(body, &inventory)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+func (c *Client) ListResourceInventories() ([]ResourceInventory, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%sapi/cloudresource/configurations", c.HostURL), nil)
+	if err != nil {
+		return nil, err
 	}
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
 
-	_, err = c.doRequest(req, &c.Token)
+	body, err := c.doRequest(req, &c.Token)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	inventories := []ResourceInventory{}
+	err = json.Unmarshal(body, &inventories)
+	if err != nil {
+		return nil, err
+	}
+
+	return inventories, nil
 }
 
 func (c *Client) DeleteResourceInventory(credentials string) error {
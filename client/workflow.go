@@ -1,9 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
+	"time"
 )
 
 func (c *Client) GetWorkflow(workflow_name string) (Workflow, error) {
@@ -52,3 +56,73 @@ func (c *Client) GetSpaceWorkflows(space_name string) ([]SpaceWorkflow, error) {
 
 	return workflows, nil
 }
+
+func (c *Client) RunEnvironmentWorkflow(space_name string, environment_id string, workflow_name string, inputs map[string]string) (string, error) {
+	data := EnvironmentWorkflowRunRequest{
+		Inputs: inputs,
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Fatalf("impossible to marshall environment workflow run request: %s", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%sapi/spaces/%s/environments/%s/workflows/%s", c.HostURL, space_name, environment_id, workflow_name), bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	body, err := c.doRequest(req, &c.Token)
+	if err != nil {
+		return "", err
+	}
+
+	run := EnvironmentWorkflowRun{}
+	err = json.Unmarshal(body, &run)
+	if err != nil {
+		return "", err
+	}
+
+	return run.Id, nil
+}
+
+// WaitForEnvironmentWorkflowRun polls the workflow run until it completes, successfully or not.
+// An error is returned only if the run could not be polled or did not complete within the timeout.
+func (c *Client) WaitForEnvironmentWorkflowRun(space_name string, run_id string, timeout time.Duration, interval time.Duration) (*Environment, error) {
+	start := time.Now()
+	for {
+		run, _, err := c.GetEnvironmentDetails(space_name, run_id)
+		if err != nil {
+			return nil, err
+		}
+		if IsWorkflowRunCompleted(run) {
+			return run, nil
+		}
+		if time.Since(start) > timeout {
+			return nil, fmt.Errorf("timed out while waiting for workflow run %s to complete", run_id)
+		}
+		time.Sleep(interval)
+	}
+}
+
+func IsWorkflowRunCompleted(run *Environment) bool {
+	status := strings.ToLower(run.Details.ComputedStatus)
+	return run.Details.State.CurrentState == "inactive" || strings.Contains(status, "ended") || IsWorkflowRunFailed(run)
+}
+
+func IsWorkflowRunFailed(run *Environment) bool {
+	status := strings.ToLower(run.Details.ComputedStatus)
+	return strings.Contains(status, "error") || strings.Contains(status, "fail")
+}
+
+// WorkflowRunErrors joins the error messages reported by a workflow run.
+func WorkflowRunErrors(run *Environment) string {
+	messages := []string{}
+	for _, runError := range run.Details.State.Errors {
+		messages = append(messages, runError.Message)
+	}
+	return strings.Join(messages, "; ")
+}
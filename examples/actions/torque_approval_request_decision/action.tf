@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_approval_requests" "ci" {
+  space_name = "space"
+  status     = "Pending"
+  requester  = "ci-bot@example.com"
+}
+
+# Invoke with: terraform apply -invoke=action.torque_approval_request_decision.approve_ci
+action "torque_approval_request_decision" "approve_ci" {
+  config {
+    request_id = data.torque_approval_requests.ci.requests[0].id
+    decision   = "approve"
+    comment    = "Auto approved CI service account request"
+  }
+}
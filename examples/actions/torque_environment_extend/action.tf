@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+# Invoke with: terraform apply -invoke=action.torque_environment_extend.extend
+action "torque_environment_extend" "extend" {
+  config {
+    space_name     = "space"
+    environment_id = "JL4kgRgxT3Vo"
+    duration       = "P0DT2H0M0S"
+  }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_environment" "env" {
+  space            = "space"
+  blueprint_name   = "my_blueprint"
+  environment_name = "my_environment"
+
+  # Reconcile the environment every time it is updated.
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.torque_environment_reconcile.reconcile]
+    }
+  }
+}
+
+action "torque_environment_reconcile" "reconcile" {
+  config {
+    space_name     = "space"
+    environment_id = torque_environment.env.id
+  }
+}
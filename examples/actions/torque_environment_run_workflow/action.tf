@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_environment" "env" {
+  space            = "space"
+  blueprint_name   = "my_blueprint"
+  environment_name = "my_environment"
+
+  # Take a DB snapshot after the environment is launched.
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.torque_environment_run_workflow.db_snapshot]
+    }
+  }
+}
+
+action "torque_environment_run_workflow" "db_snapshot" {
+  config {
+    space_name     = "space"
+    environment_id = torque_environment.env.id
+    workflow_name  = "db-snapshot"
+    inputs = {
+      retention_days = "7"
+    }
+    wait    = true
+    timeout = 60
+  }
+}
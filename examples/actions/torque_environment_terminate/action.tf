@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+# Invoke with: terraform apply -invoke=action.torque_environment_terminate.terminate
+action "torque_environment_terminate" "terminate" {
+  config {
+    space_name     = "space"
+    environment_id = "JL4kgRgxT3Vo"
+    force          = true
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_agents" "active" {
+  type   = "K8S"
+  status = "Active"
+}
+
+output "active_agents" {
+  value = [for agent in data.torque_agents.active.agents : agent.name]
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_approval_requests" "pending" {
+  space_name = "space"
+  status     = "Pending"
+}
+
+output "pending_requests" {
+  value = [for request in data.torque_approval_requests.pending.requests : "${request.id} (${request.requester}, ${request.created_date})"]
+}
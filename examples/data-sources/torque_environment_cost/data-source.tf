@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_environment_cost" "env_cost" {
+  space_name     = "target_space"
+  environment_id = "abcd1234"
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_environments" "active_envs" {
+  space_name     = "target_space"
+  owner_email    = "owner@company.com"
+  blueprint_name = "my_blueprint"
+  status         = "active"
+  labels = {
+    team = "dev"
+  }
+  tags = {
+    activity_type = "demo"
+  }
+}
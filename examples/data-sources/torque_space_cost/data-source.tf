@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+data "torque_space_cost" "monthly_cost" {
+  space_name = "target_space"
+  from       = "2024-01-01"
+  to         = "2024-01-31"
+}
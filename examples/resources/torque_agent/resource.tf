@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_agent" "agent" {
+  name        = "eks-dev"
+  type        = "K8S"
+  description = "Agent deployed to the development EKS cluster"
+}
+
+# Write the install manifest to a file so it can be applied to the cluster with kubectl.
+resource "local_sensitive_file" "agent_manifest" {
+  content  = torque_agent.agent.install_manifest
+  filename = "${path.module}/torque-agent.yaml"
+}
+
+resource "torque_agent_space_association" "association" {
+  space_name      = "space"
+  agent_name      = torque_agent.agent.name
+  namespace       = "default"
+  service_account = "default"
+}
@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_email_approval_channel" "platform_team" {
+  name      = "platform-team"
+  approvers = ["platform-lead@example.com"]
+}
+
+# Every launch in the space requires approval.
+resource "torque_approval_policy" "production" {
+  name             = "production-launches"
+  description      = "All production launches are approved by the platform team"
+  approval_channel = torque_email_approval_channel.platform_team.name
+  space_name       = "production"
+}
+
+# Only long, expensive or GPU launches of a specific catalog item require approval.
+resource "torque_approval_policy" "gpu_cluster" {
+  name             = "gpu-cluster"
+  approval_channel = torque_email_approval_channel.platform_team.name
+  space_name       = "research"
+  blueprint_name   = "gpu-cluster"
+  repository_name  = "blueprints"
+  conditions = {
+    duration_above = "P1D"
+    cost_above     = 500
+    inputs = [
+      {
+        name   = "instance_type"
+        values = ["p4d.24xlarge", "p5.48xlarge"]
+      }
+    ]
+  }
+}
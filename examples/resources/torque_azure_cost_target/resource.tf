@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_azure_cost_target" "cost_target" {
+  name              = "azure_cost_target_name"
+  tenant_id         = "00000000-0000-0000-0000-000000000000"
+  subscription_id   = "00000000-0000-0000-0000-000000000000"
+  client_id         = "00000000-0000-0000-0000-000000000000"
+  client_secret     = "client_secret"
+  storage_account   = "costexports"
+  storage_container = "exports"
+  export_directory  = "torque"
+}
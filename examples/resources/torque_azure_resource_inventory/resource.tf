@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_azure_resource_inventory" "azure" {
+  name          = "azure-account"
+  description   = "description"
+  tenant_id     = "00000000-0000-0000-0000-000000000000"
+  client_id     = "00000000-0000-0000-0000-000000000000"
+  client_secret = "secret"
+  subscription_ids = [
+    "00000000-0000-0000-0000-000000000000"
+  ]
+}
@@ -26,4 +26,15 @@ resource "torque_catalog_item" "catalog_item" {
   allow_scheduling        = true
   custom_icon             = "blueprint_icons/key"
   labels                  = ["label1", "label2"]
+  inputs = [
+    {
+      name          = "region"
+      default_value = "eu-west-1"
+      locked        = true
+    },
+    {
+      name   = "instance_profile"
+      hidden = true
+    }
+  ]
 }
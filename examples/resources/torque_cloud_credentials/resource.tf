@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_cloud_credentials" "aws" {
+  name                = "aws-production"
+  description         = "Role used to deploy production environments"
+  allowed_space_names = ["production"]
+  aws = {
+    account_number = "123456789012"
+    role_arn       = "arn:aws:iam::123456789012:role/torque-role"
+    external_id    = "external-id"
+  }
+}
+
+resource "torque_cloud_credentials" "azure" {
+  name = "azure-dev"
+  azure = {
+    tenant_id     = "00000000-0000-0000-0000-000000000000"
+    client_id     = "11111111-1111-1111-1111-111111111111"
+    client_secret = var.azure_client_secret # write-only, never stored in the state
+  }
+  secrets_version = 1 # increment to update the client secret in Torque
+}
+
+resource "torque_cloud_credentials" "gcp" {
+  name = "gcp-dev"
+  gcp = {
+    project_id          = "my-project"
+    service_account_key = file("service-account.json")
+  }
+}
+
+resource "torque_cloud_credentials" "kubernetes" {
+  name = "eks-dev"
+  kubernetes = {
+    cluster_name = "eks-dev"
+    kubeconfig   = file("kubeconfig.yaml")
+  }
+}
+
+variable "azure_client_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_eac_environment" "staging" {
+  space_name      = "space_name"
+  repository_name = "environments"
+  path            = "environments/staging.yaml"
+}
+
+output "staging_environment_id" {
+  value = torque_eac_environment.staging.environment_id
+}
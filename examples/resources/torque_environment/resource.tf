@@ -20,6 +20,7 @@ resource "torque_environment" "example" {
   duration         = "PT2H" # ISO 8601 duration format - must not be specified together with scheduled_end_time. Both can be omitted to create always-on environment.
   space            = "MySpace"
   force_destroy    = true
+  auto_update      = true # update outdated grains to the latest commit of their source during apply
   inputs = {
     "agent" = "playground",
     "name"  = "name"
@@ -28,6 +29,12 @@ resource "torque_environment" "example" {
   tags = {
     "activity_type" = "demo"
   }
+  labels = [
+    {
+      key   = "team"
+      value = "platform"
+    }
+  ]
   collaborators = {
     collaborators_emails = []
     all_space_members    = true
@@ -59,3 +66,18 @@ resource "torque_environment" "example" {
     }
   ]
 }
+
+resource "torque_environment" "preview" {
+  blueprint_name   = "web-app"
+  environment_name = "PR Preview"
+  space            = "MySpace"
+  duration         = "PT4H"
+  inputs = {
+    "agent" = "playground"
+  }
+  blueprint_git_source = { # launch from a repository that is not onboarded to the space, use blueprint_yaml to provide the blueprint inline
+    url  = "https://github.com/my-org/web-app.git"
+    ref  = "feature/new-homepage"
+    path = "blueprints/web-app.yaml"
+  }
+}
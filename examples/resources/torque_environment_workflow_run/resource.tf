@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_environment_workflow_run" "db_snapshot" {
+  space_name     = "space"
+  environment_id = "JL4kgRgxT3Vo"
+  workflow_name  = "db-snapshot"
+  inputs = {
+    retention_days = "7"
+  }
+  triggers = {
+    snapshot_date = "2024-10-01"
+  }
+  timeout = 60
+}
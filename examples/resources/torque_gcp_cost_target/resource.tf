@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_gcp_cost_target" "cost_target" {
+  name                = "gcp_cost_target_name"
+  project_id          = "billing-project"
+  billing_dataset     = "billing_export"
+  billing_table       = "gcp_billing_export_v1_000000_000000_000000"
+  service_account_key = file("service-account.json")
+}
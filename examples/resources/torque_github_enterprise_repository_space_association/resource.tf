@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_github_enterprise_repository_space_association" "repository" {
+  space_name        = "space_name"
+  repository_name   = "repo_name"
+  repository_url    = "https://github-on-prem.example.com/org/repo_name"
+  branch            = "main"
+  credential_name   = "github-enterprise-credentials"
+  use_all_agents    = false
+  agents            = ["on-prem-agent"]
+  timeout           = 2
+  auto_register_eac = true
+}
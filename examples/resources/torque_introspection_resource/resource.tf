@@ -49,3 +49,13 @@ resource "torque_introspection_resource" "example" {
       "color" : "#00ff00"
   }]
 }
+
+resource "torque_introspection_resource" "published" {
+  display_name   = "Database"
+  space_name     = "MySpace"
+  environment_id = "abcd1234"
+  grain_path     = "database"
+  introspection_data = {
+    "engine" : "postgres"
+  }
+}
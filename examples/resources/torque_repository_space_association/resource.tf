@@ -19,4 +19,30 @@ resource "torque_repository_space_association" "repository" {
   repository_type = "repository_type"
   branch          = "branch"
   repository_name = "repository_name"
+  resync_trigger  = "1" # change to make Torque rescan the repository
+}
+
+resource "torque_repository_space_association" "github_app_repository" {
+  space_name                 = "space_name"
+  repository_url             = "https://github.com/my-org/my-repo"
+  repository_type            = "github"
+  branch                     = "main"
+  repository_name            = "my-repo"
+  github_app_installation_id = "12345678" # installation of the Torque GitHub App, no personal access token required
+}
+
+resource "torque_repository_space_association" "selective_discovery" {
+  space_name        = "space_name"
+  repository_url    = "https://github.com/my-org/monorepo"
+  repository_type   = "github"
+  branch            = "main"
+  repository_name   = "monorepo"
+  credential_name   = "github-credentials"
+  auto_register_eac = true
+  discovery_folders = [
+    {
+      path    = "platform/blueprints"
+      include = ["*.yaml"]
+    }
+  ]
 }
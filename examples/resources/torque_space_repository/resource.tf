@@ -0,0 +1,80 @@
+terraform {
+  required_providers {
+    torque = {
+      source = "qualitorque/torque"
+    }
+  }
+}
+
+provider "torque" {
+  host  = "https://portal.qtorque.io/"
+  space = "space"
+  token = "111111111111"
+}
+
+resource "torque_space_repository" "github" {
+  space_name      = "space_name"
+  repository_name = "blueprints"
+  repository_url  = "https://github.com/my-org/blueprints"
+  type            = "github"
+  branch          = "main"
+  credential_name = "github-credentials"
+
+  # Only discover production blueprints and the workflows of the release branch.
+  discovery_folders = [
+    {
+      path    = "blueprints"
+      include = ["prod-*.yaml"]
+      exclude = ["drafts/**"]
+    },
+    {
+      path   = "workflows"
+      type   = "workflow"
+      branch = "release"
+    }
+  ]
+}
+
+resource "torque_space_repository" "github_enterprise" {
+  space_name        = "space_name"
+  repository_name   = "on-prem-blueprints"
+  repository_url    = "https://github-on-prem.example.com/org/blueprints"
+  type              = "github_enterprise"
+  branch            = "main"
+  credential_name   = "github-enterprise-credentials"
+  auto_register_eac = true
+  agents = {
+    names = ["on-prem-agent"]
+  }
+}
+
+resource "torque_space_repository" "codecommit" {
+  space_name      = "space_name"
+  repository_name = "codecommit-blueprints"
+  repository_url  = "https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/blueprints"
+  type            = "codecommit"
+  branch          = "main"
+  credential_name = "codecommit-credentials"
+  codecommit = {
+    role_arn     = "arn:aws:iam::123456789012:role/torque-codecommit"
+    aws_region   = "eu-west-1"
+    external_id  = "external-id"
+    git_username = "username"
+    git_password = "password"
+  }
+}
+
+# Migrate a repository onboarded with one of the per-vendor resources without recreating it.
+moved {
+  from = torque_gitlab_enterprise_repository_space_association.repository
+  to   = torque_space_repository.gitlab_enterprise
+}
+
+resource "torque_space_repository" "gitlab_enterprise" {
+  space_name      = "space_name"
+  repository_name = "gitlab-blueprints"
+  repository_url  = "https://gitlab-on-prem.example.com/blueprints"
+  type            = "gitlab_enterprise"
+  branch          = "main"
+  credential_name = "gitlab-credentials"
+}
@@ -0,0 +1,102 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &TorqueApprovalRequestDecisionAction{}
+	_ action.ActionWithConfigure = &TorqueApprovalRequestDecisionAction{}
+)
+
+func NewTorqueApprovalRequestDecisionAction() action.Action {
+	return &TorqueApprovalRequestDecisionAction{}
+}
+
+// TorqueApprovalRequestDecisionAction defines the action implementation.
+type TorqueApprovalRequestDecisionAction struct {
+	client *client.Client
+}
+
+// TorqueApprovalRequestDecisionActionModel describes the action data model.
+type TorqueApprovalRequestDecisionActionModel struct {
+	RequestId types.String `tfsdk:"request_id"`
+	Decision  types.String `tfsdk:"decision"`
+	Comment   types.String `tfsdk:"comment"`
+}
+
+func (a *TorqueApprovalRequestDecisionAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approval_request_decision"
+}
+
+func (a *TorqueApprovalRequestDecisionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Approves or denies a pending Torque approval request.",
+		Attributes: map[string]schema.Attribute{
+			"request_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the approval request to decide on",
+				Required:            true,
+			},
+			"decision": schema.StringAttribute{
+				MarkdownDescription: "The decision on the approval request. One of `approve` or `deny`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("approve", "deny"),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment recorded with the decision",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *TorqueApprovalRequestDecisionAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TorqueApprovalRequestDecisionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TorqueApprovalRequestDecisionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if data.Decision.ValueString() == "approve" {
+		err = a.client.ApproveApprovalRequest(data.RequestId.ValueString(), data.Comment.ValueString())
+	} else {
+		err = a.client.DenyApprovalRequest(data.RequestId.ValueString(), data.Comment.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s approval request '%s', got error: %s", data.Decision.ValueString(), data.RequestId.ValueString(), err))
+		return
+	}
+}
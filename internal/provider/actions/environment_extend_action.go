@@ -0,0 +1,92 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &TorqueEnvironmentExtendAction{}
+	_ action.ActionWithConfigure = &TorqueEnvironmentExtendAction{}
+)
+
+func NewTorqueEnvironmentExtendAction() action.Action {
+	return &TorqueEnvironmentExtendAction{}
+}
+
+// TorqueEnvironmentExtendAction defines the action implementation.
+type TorqueEnvironmentExtendAction struct {
+	client *client.Client
+}
+
+// TorqueEnvironmentExtendActionModel describes the action data model.
+type TorqueEnvironmentExtendActionModel struct {
+	SpaceName     types.String `tfsdk:"space_name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Duration      types.String `tfsdk:"duration"`
+}
+
+func (a *TorqueEnvironmentExtendAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_extend"
+}
+
+func (a *TorqueEnvironmentExtendAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Extends the duration of a running Torque environment.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "The space of the environment",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to extend",
+				Required:            true,
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "Time to add to the environment duration in ISO 8601 format: 'P{days}DT{hours}H{minutes}M{seconds}S' For example, P0DT2H3M4S.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *TorqueEnvironmentExtendAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TorqueEnvironmentExtendAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TorqueEnvironmentExtendActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.ExtendEnvironment(data.SpaceName.ValueString(), data.EnvironmentId.ValueString(), data.Duration.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to extend environment '%s', got error: %s", data.EnvironmentId.ValueString(), err))
+		return
+	}
+}
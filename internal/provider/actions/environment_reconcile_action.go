@@ -0,0 +1,87 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &TorqueEnvironmentReconcileAction{}
+	_ action.ActionWithConfigure = &TorqueEnvironmentReconcileAction{}
+)
+
+func NewTorqueEnvironmentReconcileAction() action.Action {
+	return &TorqueEnvironmentReconcileAction{}
+}
+
+// TorqueEnvironmentReconcileAction defines the action implementation.
+type TorqueEnvironmentReconcileAction struct {
+	client *client.Client
+}
+
+// TorqueEnvironmentReconcileActionModel describes the action data model.
+type TorqueEnvironmentReconcileActionModel struct {
+	SpaceName     types.String `tfsdk:"space_name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+}
+
+func (a *TorqueEnvironmentReconcileAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_reconcile"
+}
+
+func (a *TorqueEnvironmentReconcileAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reconciles a Torque environment, refreshing the environment resources and their status from the cloud.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "The space of the environment",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to reconcile",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *TorqueEnvironmentReconcileAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TorqueEnvironmentReconcileAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TorqueEnvironmentReconcileActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.ReconcileEnvironment(data.SpaceName.ValueString(), data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile environment '%s', got error: %s", data.EnvironmentId.ValueString(), err))
+		return
+	}
+}
@@ -0,0 +1,141 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &TorqueEnvironmentRunWorkflowAction{}
+	_ action.ActionWithConfigure = &TorqueEnvironmentRunWorkflowAction{}
+)
+
+func NewTorqueEnvironmentRunWorkflowAction() action.Action {
+	return &TorqueEnvironmentRunWorkflowAction{}
+}
+
+// TorqueEnvironmentRunWorkflowAction defines the action implementation.
+type TorqueEnvironmentRunWorkflowAction struct {
+	client *client.Client
+}
+
+// TorqueEnvironmentRunWorkflowActionModel describes the action data model.
+type TorqueEnvironmentRunWorkflowActionModel struct {
+	SpaceName     types.String `tfsdk:"space_name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	WorkflowName  types.String `tfsdk:"workflow_name"`
+	Inputs        types.Map    `tfsdk:"inputs"`
+	Wait          types.Bool   `tfsdk:"wait"`
+	TimeOut       types.Int32  `tfsdk:"timeout"`
+}
+
+func (a *TorqueEnvironmentRunWorkflowAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_run_workflow"
+}
+
+func (a *TorqueEnvironmentRunWorkflowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an environment or environment resource scoped workflow on an existing Torque environment.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "The space of the environment",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to run the workflow on",
+				Required:            true,
+			},
+			"workflow_name": schema.StringAttribute{
+				MarkdownDescription: "Name of an existing and enabled workflow in the space to run",
+				Required:            true,
+			},
+			"inputs": schema.MapAttribute{
+				MarkdownDescription: "Dictionary of key-value string pairs that will be used as values for the workflow inputs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the workflow run to complete. Default is true.",
+				Optional:            true,
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "Time in minutes to wait for the workflow run to complete. Default is 30 minutes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *TorqueEnvironmentRunWorkflowAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TorqueEnvironmentRunWorkflowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TorqueEnvironmentRunWorkflowActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	const (
+		DefaultTimeout = 30
+		Interval       = 10 * time.Second
+	)
+
+	inputs := make(map[string]string)
+	resp.Diagnostics.Append(data.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := a.client.RunEnvironmentWorkflow(data.SpaceName.ValueString(), data.EnvironmentId.ValueString(), data.WorkflowName.ValueString(), inputs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run workflow '%s' on environment '%s', got error: %s", data.WorkflowName.ValueString(), data.EnvironmentId.ValueString(), err))
+		return
+	}
+	if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+		return
+	}
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Waiting for workflow '%s' run '%s' to complete", data.WorkflowName.ValueString(), id),
+		})
+	}
+
+	timeout := time.Duration(DefaultTimeout) * time.Minute
+	if !data.TimeOut.IsNull() {
+		timeout = time.Duration(data.TimeOut.ValueInt32()) * time.Minute
+	}
+	run, err := a.client.WaitForEnvironmentWorkflowRun(data.SpaceName.ValueString(), id, timeout, Interval)
+	if err != nil {
+		resp.Diagnostics.AddError("Workflow Run Error", fmt.Sprintf("Failed waiting for workflow '%s' run '%s' to complete: %s", data.WorkflowName.ValueString(), id, err))
+		return
+	}
+	if client.IsWorkflowRunFailed(run) {
+		resp.Diagnostics.AddError("Workflow Run Failed", fmt.Sprintf("Workflow '%s' run '%s' ended with status '%s': %s", data.WorkflowName.ValueString(), id, run.Details.ComputedStatus, client.WorkflowRunErrors(run)))
+		return
+	}
+}
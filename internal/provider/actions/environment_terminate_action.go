@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &TorqueEnvironmentTerminateAction{}
+	_ action.ActionWithConfigure = &TorqueEnvironmentTerminateAction{}
+)
+
+func NewTorqueEnvironmentTerminateAction() action.Action {
+	return &TorqueEnvironmentTerminateAction{}
+}
+
+// TorqueEnvironmentTerminateAction defines the action implementation.
+type TorqueEnvironmentTerminateAction struct {
+	client *client.Client
+}
+
+// TorqueEnvironmentTerminateActionModel describes the action data model.
+type TorqueEnvironmentTerminateActionModel struct {
+	SpaceName     types.String `tfsdk:"space_name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Force         types.Bool   `tfsdk:"force"`
+}
+
+func (a *TorqueEnvironmentTerminateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_terminate"
+}
+
+func (a *TorqueEnvironmentTerminateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Terminates a Torque environment. Environments that already ended are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "The space of the environment",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to terminate",
+				Required:            true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment should be force terminated if any errors occurred during the teardown. Default is false.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *TorqueEnvironmentTerminateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TorqueEnvironmentTerminateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TorqueEnvironmentTerminateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	const Inactive = "inactive"
+
+	env, _, err := a.client.GetEnvironmentDetails(data.SpaceName.ValueString(), data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment '%s', got error: %s", data.EnvironmentId.ValueString(), err))
+		return
+	}
+	if env.Details.State.CurrentState == Inactive {
+		return
+	}
+	err = a.client.TerminateEnvironment(data.SpaceName.ValueString(), data.EnvironmentId.ValueString())
+	if err == nil {
+		return
+	}
+	if !data.Force.ValueBool() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to terminate environment, env status:%s, got error: %s", env.Details.State.CurrentState, err))
+		return
+	}
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Failed to terminate environment '%s', force terminating it", data.EnvironmentId.ValueString()),
+		})
+	}
+	err = a.client.ForceTerminateEnvironment(data.SpaceName.ValueString(), data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to force terminate environment, env status:%s, got error: %s", env.Details.State.CurrentState, err))
+		return
+	}
+}
@@ -0,0 +1,171 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &agentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &agentsDataSource{}
+)
+
+// NewAgentsDataSource is a helper function to simplify the provider implementation.
+func NewAgentsDataSource() datasource.DataSource {
+	return &agentsDataSource{}
+}
+
+// agentsDataSource is the data source implementation.
+type agentsDataSource struct {
+	client *client.Client
+}
+
+// agentsDataSourceModel maps the data source schema data.
+type agentsDataSourceModel struct {
+	Type      types.String `tfsdk:"type"`
+	Status    types.String `tfsdk:"status"`
+	SpaceName types.String `tfsdk:"space_name"`
+	Agents    []agentModel `tfsdk:"agents"`
+}
+
+type agentModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Status   types.String `tfsdk:"status"`
+	Version  types.String `tfsdk:"version"`
+	LastSeen types.String `tfsdk:"last_seen"`
+	Spaces   types.List   `tfsdk:"spaces"`
+}
+
+// Metadata returns the data source type name.
+func (d *agentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agents"
+}
+
+// Schema defines the schema for the data source.
+func (d *agentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the agents registered in the Torque account and their connectivity status.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return agents of this type, for example `K8S`",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return agents with this status, for example `Active`",
+				Optional:            true,
+			},
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Only return agents associated with this space",
+				Optional:            true,
+			},
+			"agents": schema.ListNestedAttribute{
+				Description: "Agents registered in the account",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the agent",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the agent",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The connectivity status of the agent",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The version of the installed agent",
+							Computed:            true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "The last time the agent connected to Torque",
+							Computed:            true,
+						},
+						"spaces": schema.ListAttribute{
+							MarkdownDescription: "The spaces the agent is associated with",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *agentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *agentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state agentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agents, err := d.client.ListAgents()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque agents",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Agents = []agentModel{}
+	for _, agent := range agents {
+		if !state.Type.IsNull() && agent.Type != state.Type.ValueString() {
+			continue
+		}
+		if !state.Status.IsNull() && agent.Status != state.Status.ValueString() {
+			continue
+		}
+		if !state.SpaceName.IsNull() && !slices.Contains(agent.Spaces, state.SpaceName.ValueString()) {
+			continue
+		}
+		spaces, diags := types.ListValueFrom(ctx, types.StringType, agent.Spaces)
+		resp.Diagnostics.Append(diags...)
+		state.Agents = append(state.Agents, agentModel{
+			Name:     types.StringValue(agent.Name),
+			Type:     types.StringValue(agent.Type),
+			Status:   types.StringValue(agent.Status),
+			Version:  types.StringValue(agent.Version),
+			LastSeen: types.StringValue(agent.LastSeen),
+			Spaces:   spaces,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,175 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &approvalRequestsDataSource{}
+	_ datasource.DataSourceWithConfigure = &approvalRequestsDataSource{}
+)
+
+// NewApprovalRequestsDataSource is a helper function to simplify the provider implementation.
+func NewApprovalRequestsDataSource() datasource.DataSource {
+	return &approvalRequestsDataSource{}
+}
+
+// approvalRequestsDataSource is the data source implementation.
+type approvalRequestsDataSource struct {
+	client *client.Client
+}
+
+// approvalRequestsDataSourceModel maps the data source schema data.
+type approvalRequestsDataSourceModel struct {
+	SpaceName       types.String           `tfsdk:"space_name"`
+	ApprovalChannel types.String           `tfsdk:"approval_channel"`
+	Status          types.String           `tfsdk:"status"`
+	Requester       types.String           `tfsdk:"requester"`
+	Requests        []approvalRequestModel `tfsdk:"requests"`
+}
+
+type approvalRequestModel struct {
+	Id              types.String `tfsdk:"id"`
+	SpaceName       types.String `tfsdk:"space_name"`
+	ApprovalChannel types.String `tfsdk:"approval_channel"`
+	Status          types.String `tfsdk:"status"`
+	Requester       types.String `tfsdk:"requester"`
+	EnvironmentId   types.String `tfsdk:"environment_id"`
+	BlueprintName   types.String `tfsdk:"blueprint_name"`
+	CreatedDate     types.String `tfsdk:"created_date"`
+}
+
+// Metadata returns the data source type name.
+func (d *approvalRequestsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approval_requests"
+}
+
+// Schema defines the schema for the data source.
+func (d *approvalRequestsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the approval requests of the Torque account, for example the requests pending a decision in a space.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Only return approval requests of this space",
+				Optional:            true,
+			},
+			"approval_channel": schema.StringAttribute{
+				MarkdownDescription: "Only return approval requests sent to this approval channel",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return approval requests with this status, for example `Pending`",
+				Optional:            true,
+			},
+			"requester": schema.StringAttribute{
+				MarkdownDescription: "Only return approval requests made by the user with this email",
+				Optional:            true,
+			},
+			"requests": schema.ListNestedAttribute{
+				Description: "Approval requests matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the approval request",
+							Computed:            true,
+						},
+						"space_name": schema.StringAttribute{
+							MarkdownDescription: "The space of the approval request",
+							Computed:            true,
+						},
+						"approval_channel": schema.StringAttribute{
+							MarkdownDescription: "The approval channel the request was sent to",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the approval request",
+							Computed:            true,
+						},
+						"requester": schema.StringAttribute{
+							MarkdownDescription: "Email of the user that made the request",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the environment awaiting the approval",
+							Computed:            true,
+						},
+						"blueprint_name": schema.StringAttribute{
+							MarkdownDescription: "The blueprint of the environment awaiting the approval",
+							Computed:            true,
+						},
+						"created_date": schema.StringAttribute{
+							MarkdownDescription: "The time the approval request was made",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *approvalRequestsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *approvalRequestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state approvalRequestsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requests, err := d.client.GetApprovalRequests(state.SpaceName.ValueString(), state.ApprovalChannel.ValueString(), state.Status.ValueString(), state.Requester.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque approval requests",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Requests = []approvalRequestModel{}
+	for _, request := range requests {
+		state.Requests = append(state.Requests, approvalRequestModel{
+			Id:              types.StringValue(request.Id),
+			SpaceName:       types.StringValue(request.SpaceName),
+			ApprovalChannel: types.StringValue(request.ApprovalChannel),
+			Status:          types.StringValue(request.Status),
+			Requester:       types.StringValue(request.Requester),
+			EnvironmentId:   types.StringValue(request.EnvironmentId),
+			BlueprintName:   types.StringValue(request.BlueprintName),
+			CreatedDate:     types.StringValue(request.CreatedDate),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,180 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &environmentCostDataSource{}
+	_ datasource.DataSourceWithConfigure = &environmentCostDataSource{}
+)
+
+// NewEnvironmentCostDataSource is a helper function to simplify the provider implementation.
+func NewEnvironmentCostDataSource() datasource.DataSource {
+	return &environmentCostDataSource{}
+}
+
+// environmentCostDataSource is the data source implementation.
+type environmentCostDataSource struct {
+	client *client.Client
+}
+
+// environmentCostDataSourceModel maps the data source schema data.
+type environmentCostDataSourceModel struct {
+	SpaceName     types.String        `tfsdk:"space_name"`
+	EnvironmentId types.String        `tfsdk:"environment_id"`
+	Total         types.Float64       `tfsdk:"total"`
+	Currency      types.String        `tfsdk:"currency"`
+	From          types.String        `tfsdk:"from"`
+	To            types.String        `tfsdk:"to"`
+	Resources     []resourceCostModel `tfsdk:"resources"`
+}
+
+type resourceCostModel struct {
+	Name          types.String  `tfsdk:"name"`
+	Type          types.String  `tfsdk:"type"`
+	GrainPath     types.String  `tfsdk:"grain_path"`
+	EnvironmentId types.String  `tfsdk:"environment_id"`
+	Cost          types.Float64 `tfsdk:"cost"`
+}
+
+// Metadata returns the data source type name.
+func (d *environmentCostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_cost"
+}
+
+// Schema defines the schema for the data source.
+func (d *environmentCostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the cost of a Torque environment, as collected by the cost targets configured in the account.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Torque's space the environment belongs to",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment",
+				Required:            true,
+			},
+			"total": schema.Float64Attribute{
+				MarkdownDescription: "Total cost of the environment",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Currency of the cost values",
+				Computed:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start of the time range the cost was collected for",
+				Computed:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End of the time range the cost was collected for",
+				Computed:            true,
+			},
+			"resources": resourceCostsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *environmentCostDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *environmentCostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state environmentCostDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cost, err := d.client.GetEnvironmentCost(state.SpaceName.ValueString(), state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque environment cost",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Total = types.Float64Value(cost.Total)
+	state.Currency = types.StringValue(cost.Currency)
+	state.From = types.StringValue(cost.From)
+	state.To = types.StringValue(cost.To)
+	state.Resources = resourceCosts(cost.Resources)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func resourceCostsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Cost breakdown per resource",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the cloud resource",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of the cloud resource",
+					Computed:            true,
+				},
+				"grain_path": schema.StringAttribute{
+					MarkdownDescription: "Path of the environment grain that deployed the resource",
+					Computed:            true,
+				},
+				"environment_id": schema.StringAttribute{
+					MarkdownDescription: "Id of the environment the resource belongs to",
+					Computed:            true,
+				},
+				"cost": schema.Float64Attribute{
+					MarkdownDescription: "Cost of the resource",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func resourceCosts(resources []client.ResourceCost) []resourceCostModel {
+	costs := []resourceCostModel{}
+	for _, resource := range resources {
+		costs = append(costs, resourceCostModel{
+			Name:          types.StringValue(resource.Name),
+			Type:          types.StringValue(resource.Type),
+			GrainPath:     types.StringValue(resource.GrainPath),
+			EnvironmentId: types.StringValue(resource.EnvironmentId),
+			Cost:          types.Float64Value(resource.Cost),
+		})
+	}
+	return costs
+}
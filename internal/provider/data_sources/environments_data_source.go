@@ -0,0 +1,259 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &environmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &environmentsDataSource{}
+)
+
+const (
+	environmentStatusActive = "active"
+	environmentStatusEnded  = "ended"
+	environmentStatusError  = "error"
+)
+
+// NewEnvironmentsDataSource is a helper function to simplify the provider implementation.
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &environmentsDataSource{}
+}
+
+// environmentsDataSource is the data source implementation.
+type environmentsDataSource struct {
+	client *client.Client
+}
+
+// environmentsDataSourceModel maps the data source schema data.
+type environmentsDataSourceModel struct {
+	SpaceName     types.String              `tfsdk:"space_name"`
+	OwnerEmail    types.String              `tfsdk:"owner_email"`
+	BlueprintName types.String              `tfsdk:"blueprint_name"`
+	Status        types.String              `tfsdk:"status"`
+	Labels        map[string]string         `tfsdk:"labels"`
+	Tags          map[string]string         `tfsdk:"tags"`
+	Environments  []environmentSummaryModel `tfsdk:"environments"`
+}
+
+type environmentSummaryModel struct {
+	Id            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	BlueprintName types.String  `tfsdk:"blueprint_name"`
+	OwnerEmail    types.String  `tfsdk:"owner_email"`
+	Status        types.String  `tfsdk:"status"`
+	StartTime     types.String  `tfsdk:"start_time"`
+	EndTime       types.String  `tfsdk:"end_time"`
+	Cost          types.Float64 `tfsdk:"cost"`
+}
+
+// Metadata returns the data source type name.
+func (d *environmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environments"
+}
+
+// Schema defines the schema for the data source.
+func (d *environmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a list of environments in a space, optionally filtered by owner, blueprint, status, labels and tags.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Torque's space to list the environments in",
+				Required:            true,
+			},
+			"owner_email": schema.StringAttribute{
+				MarkdownDescription: "Only return environments owned by the user with this email address",
+				Optional:            true,
+			},
+			"blueprint_name": schema.StringAttribute{
+				MarkdownDescription: "Only return environments that were launched from this blueprint",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return environments in this status. Possible values: `active`, `ended` and `error`. The `error` status also includes environments that ended with an error. If omitted, environments in all statuses are returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{environmentStatusActive, environmentStatusEnded, environmentStatusError}...),
+				},
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only return environments that have all of these label key-value pairs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only return environments that have all of these tag name-value pairs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"environments": schema.ListNestedAttribute{
+				Description: "Environments matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Environment ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the environment",
+							Computed:            true,
+						},
+						"blueprint_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the blueprint that was used to launch this environment from",
+							Computed:            true,
+						},
+						"owner_email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the person who owns this environment",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Environment status",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Datetime string representing the time this environment was launched",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "Datetime string representing the time the environment has ended (if ended)",
+							Computed:            true,
+						},
+						"cost": schema.Float64Attribute{
+							MarkdownDescription: "Accumulated cost of the environment, null if cost data is not available",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *environmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *environmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state environmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := state.Status.ValueString()
+	// Environments can also end with an error, so ended environments are needed for the error status too.
+	show_ended := status != environmentStatusActive
+	environments, err := d.client.GetEnvironments(state.SpaceName.ValueString(), state.OwnerEmail.ValueString(), state.BlueprintName.ValueString(), show_ended)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque environments",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Environments = []environmentSummaryModel{}
+	for _, environment := range environments {
+		if !environmentMatches(environment, state) {
+			continue
+		}
+		environmentData := environmentSummaryModel{
+			Id:            types.StringValue(environment.Details.Id),
+			Name:          types.StringValue(environment.Details.Definition.Metadata.Name),
+			BlueprintName: types.StringValue(environment.Details.Definition.Metadata.BlueprintName),
+			OwnerEmail:    types.StringValue(environment.Owner.OwnerEmail),
+			Status:        types.StringValue(environment.Details.ComputedStatus),
+			StartTime:     types.StringValue(environment.Details.State.Execution.StartTime),
+			EndTime:       types.StringValue(environment.Details.State.Execution.EndTime),
+			Cost:          types.Float64Null(),
+		}
+		if environment.Cost != nil {
+			environmentData.Cost = types.Float64Value(environment.Cost.Sum)
+		}
+		state.Environments = append(state.Environments, environmentData)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// environmentMatches applies the data source filters on an environment. Filters are applied
+// on the returned environments as well, since not all of them are supported by the API.
+func environmentMatches(environment client.Environment, filters environmentsDataSourceModel) bool {
+	metadata := environment.Details.Definition.Metadata
+	if !filters.OwnerEmail.IsNull() && !strings.EqualFold(environment.Owner.OwnerEmail, filters.OwnerEmail.ValueString()) {
+		return false
+	}
+	if !filters.BlueprintName.IsNull() && metadata.BlueprintName != filters.BlueprintName.ValueString() {
+		return false
+	}
+	if !filters.Status.IsNull() && !environmentStatusMatches(environment.Details.ComputedStatus, filters.Status.ValueString()) {
+		return false
+	}
+	labels := make(map[string]string)
+	for _, label := range environment.Details.Definition.Labels {
+		labels[label.Key] = label.Value
+	}
+	for key, value := range filters.Labels {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	tags := make(map[string]string)
+	for _, tag := range environment.Details.Definition.Tags {
+		tags[tag.Name] = tag.Value
+	}
+	for name, value := range filters.Tags {
+		if tagValue, ok := tags[name]; !ok || tagValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// environmentStatusMatches maps Torque's computed environment status (e.g. "Active With Error",
+// "Terminate Failed") to the coarse status values supported by the data source filter.
+func environmentStatusMatches(computedStatus string, status string) bool {
+	computedStatus = strings.ToLower(computedStatus)
+	isError := strings.Contains(computedStatus, "error") || strings.Contains(computedStatus, "fail")
+	switch status {
+	case environmentStatusError:
+		return isError
+	case environmentStatusEnded:
+		return strings.Contains(computedStatus, "ended") && !isError
+	case environmentStatusActive:
+		return !strings.Contains(computedStatus, "ended") && !isError
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resourceInventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceInventoryDataSource{}
+)
+
+// NewResourceInventoryDataSource is a helper function to simplify the provider implementation.
+func NewResourceInventoryDataSource() datasource.DataSource {
+	return &resourceInventoryDataSource{}
+}
+
+// resourceInventoryDataSource is the data source implementation.
+type resourceInventoryDataSource struct {
+	client *client.Client
+}
+
+// resourceInventoryDataSourceModel maps the data source schema data.
+type resourceInventoryDataSourceModel struct {
+	CloudType   types.String             `tfsdk:"cloud_type"`
+	Inventories []resourceInventoryModel `tfsdk:"inventories"`
+}
+
+type resourceInventoryModel struct {
+	Credentials     types.String `tfsdk:"credentials"`
+	CloudType       types.String `tfsdk:"cloud_type"`
+	ViewArn         types.String `tfsdk:"view_arn"`
+	SubscriptionIds types.List   `tfsdk:"subscription_ids"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// Metadata returns the data source type name.
+func (d *resourceInventoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_inventory"
+}
+
+// Schema defines the schema for the data source.
+func (d *resourceInventoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the cloud resource inventories configured in the Torque account, one per cloud account credentials.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_type": schema.StringAttribute{
+				MarkdownDescription: "Only return resource inventories of this cloud type, for example `aws` or `azure`",
+				Optional:            true,
+			},
+			"inventories": schema.ListNestedAttribute{
+				Description: "Resource inventories configured in the account",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"credentials": schema.StringAttribute{
+							MarkdownDescription: "Name of the cloud account credentials the inventory is discovered with",
+							Computed:            true,
+						},
+						"cloud_type": schema.StringAttribute{
+							MarkdownDescription: "Cloud type of the resource inventory",
+							Computed:            true,
+						},
+						"view_arn": schema.StringAttribute{
+							MarkdownDescription: "ARN of the AWS Resource Explorer view, for AWS inventories",
+							Computed:            true,
+						},
+						"subscription_ids": schema.ListAttribute{
+							MarkdownDescription: "Ids of the Azure subscriptions, for Azure inventories",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the resource discovery",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resourceInventoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resourceInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceInventoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inventories, err := d.client.ListResourceInventories()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque resource inventories",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Inventories = []resourceInventoryModel{}
+	for _, inventory := range inventories {
+		if !state.CloudType.IsNull() && inventory.Details.Type != state.CloudType.ValueString() {
+			continue
+		}
+		subscription_ids, diags := types.ListValueFrom(ctx, types.StringType, inventory.Details.SubscriptionIds)
+		resp.Diagnostics.Append(diags...)
+		state.Inventories = append(state.Inventories, resourceInventoryModel{
+			Credentials:     types.StringValue(inventory.Credentials),
+			CloudType:       types.StringValue(inventory.Details.Type),
+			ViewArn:         types.StringPointerValue(inventory.Details.ViewArn),
+			SubscriptionIds: subscription_ids,
+			Status:          types.StringValue(inventory.Status),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,164 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &spaceCostDataSource{}
+	_ datasource.DataSourceWithConfigure      = &spaceCostDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &spaceCostDataSource{}
+)
+
+var costDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// NewSpaceCostDataSource is a helper function to simplify the provider implementation.
+func NewSpaceCostDataSource() datasource.DataSource {
+	return &spaceCostDataSource{}
+}
+
+// spaceCostDataSource is the data source implementation.
+type spaceCostDataSource struct {
+	client *client.Client
+}
+
+// spaceCostDataSourceModel maps the data source schema data.
+type spaceCostDataSourceModel struct {
+	SpaceName types.String        `tfsdk:"space_name"`
+	From      types.String        `tfsdk:"from"`
+	To        types.String        `tfsdk:"to"`
+	Total     types.Float64       `tfsdk:"total"`
+	Currency  types.String        `tfsdk:"currency"`
+	Resources []resourceCostModel `tfsdk:"resources"`
+}
+
+// Metadata returns the data source type name.
+func (d *spaceCostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_cost"
+}
+
+// Schema defines the schema for the data source.
+func (d *spaceCostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the cost of the environments in a Torque space over a date window, as collected by the cost targets configured in the account.",
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Torque's space to get the cost of",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start date of the time range in YYYY-MM-DD format",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(costDateRegex, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End date of the time range in YYYY-MM-DD format",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(costDateRegex, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"total": schema.Float64Attribute{
+				MarkdownDescription: "Total cost of the space environments in the time range",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Currency of the cost values",
+				Computed:            true,
+			},
+			"resources": resourceCostsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *spaceCostDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the time range does not end before it starts.
+func (d *spaceCostDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data spaceCostDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.From.IsNull() || data.From.IsUnknown() || data.To.IsNull() || data.To.IsUnknown() {
+		return
+	}
+	// Malformed dates are reported by the attribute validators.
+	from, err := time.Parse(time.DateOnly, data.From.ValueString())
+	if err != nil {
+		return
+	}
+	to, err := time.Parse(time.DateOnly, data.To.ValueString())
+	if err != nil {
+		return
+	}
+	if to.Before(from) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Time Range",
+			fmt.Sprintf("The end date %s is before the start date %s.", data.To.ValueString(), data.From.ValueString()),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *spaceCostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spaceCostDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cost, err := d.client.GetSpaceCost(state.SpaceName.ValueString(), state.From.ValueString(), state.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque space cost",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Total = types.Float64Value(cost.Total)
+	state.Currency = types.StringValue(cost.Currency)
+	state.Resources = resourceCosts(cost.Resources)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -11,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qualitorque/terraform-provider-torque/client"
+	"github.com/qualitorque/terraform-provider-torque/internal/provider/actions"
 	"github.com/qualitorque/terraform-provider-torque/internal/provider/data_sources"
 	"github.com/qualitorque/terraform-provider-torque/internal/provider/resources"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &torqueProvider{}
+var _ provider.ProviderWithActions = &torqueProvider{}
 
 // TorqueProvider defines the provider implementation.
 type torqueProvider struct {
@@ -197,6 +200,7 @@ func (p *torqueProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured Torque client", map[string]any{"success": true})
 }
@@ -204,6 +208,7 @@ func (p *torqueProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *torqueProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewTorqueIntrospectionResource,
+		resources.NewTorqueAgentResource,
 		resources.NewTorqueAgentSpaceAssociationResource,
 		resources.NewTorqueSpaceRepositoryResource,
 		resources.NewTorqueSpaceResource,
@@ -215,6 +220,8 @@ func (p *torqueProvider) Resources(ctx context.Context) []func() resource.Resour
 		resources.NewTorqueSpaceParameterResource,
 		resources.NewTorqueGroupResource,
 		resources.NewTorqueAwsCostTargetResource,
+		resources.NewTorqueAzureCostTargetResource,
+		resources.NewTorqueGcpCostTargetResource,
 		resources.NewTorqueTagBlueprintValueAssociationResource,
 		resources.NewTorqueSpaceEmailNotificationResource,
 		resources.NewTorqueAccountResource,
@@ -227,25 +234,33 @@ func (p *torqueProvider) Resources(ctx context.Context) []func() resource.Resour
 		resources.NewTorqueEnvironmentLabelAssociationResource,
 		resources.NewTorqueSpaceGitCredentialsResource,
 		resources.NewTorqueGitCredentialsResource,
+		resources.NewTorqueCloudCredentialsResource,
 		resources.NewTorqueEnvironmentResource,
 		resources.NewTorqueWorkflowResource,
 		resources.NewTorqueSpaceWorkflowResource,
+		resources.NewTorqueEnvironmentWorkflowRunResource,
 		resources.NewTorqueSpaceCustomIconResource,
 		resources.NewTorqueS3ObjectInputSourceResource,
 		resources.NewTorqueS3ObjectContentInputSourceResource,
 		resources.NewTorqueAwsResourceInventoryResource,
+		resources.NewTorqueAzureResourceInventoryResource,
 		resources.NewTorqueAzureBlobObjectInputSourceResource,
 		resources.NewTorqueAzureBlobObjectContentInputSourceResource,
 		resources.NewTorqueDeploymentEngineResource,
 		resources.NewTorqueEmailApprovalChannelResource,
 		resources.NewTorqueTeamsApprovalChannelResource,
 		resources.NewTorqueServiceNowApprovalChannelResource,
+		resources.NewTorqueApprovalPolicyResource,
 		resources.NewTorqueSpaceTeamsNotificationResource,
 		resources.NewTorqueSpaceSlackNotificationResource,
 		resources.NewTorqueSpaceGenericWebhookNotificationResource,
 		resources.NewTorqueAuditResource,
 		resources.NewTorqueElasticsearchAuditResource,
 		resources.NewTorqueSpaceAdoServerRepositoryResource,
+		resources.NewTorqueSpaceGithubEnterpriseRepositoryResource,
+		resources.NewTorqueSpaceBitbucketServerRepositoryResource,
+		resources.NewTorqueSpaceRepositoryV2Resource,
+		resources.NewTorqueEacEnvironmentResource,
 	}
 }
 
@@ -255,6 +270,9 @@ func (p *torqueProvider) DataSources(_ context.Context) []func() datasource.Data
 		data_sources.NewUserDataSource,
 		data_sources.NewSpaceRepositoryBlueprintsDataSource,
 		data_sources.NewEnvironmentDataSource,
+		data_sources.NewEnvironmentsDataSource,
+		data_sources.NewEnvironmentCostDataSource,
+		data_sources.NewSpaceCostDataSource,
 		data_sources.NewEnvironmentIntrospectionDataSource,
 		data_sources.NewAccountParameterDataSource,
 		data_sources.NewSpaceParameterDataSource,
@@ -262,5 +280,20 @@ func (p *torqueProvider) DataSources(_ context.Context) []func() datasource.Data
 		data_sources.NewTorqueWorkflowDataSource,
 		data_sources.NewSpaceCustomIconDataSource,
 		data_sources.NewSpacesDataSource,
+		data_sources.NewAgentsDataSource,
+		data_sources.NewResourceInventoryDataSource,
+		data_sources.NewApprovalRequestsDataSource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *torqueProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewTorqueEnvironmentExtendAction,
+		actions.NewTorqueEnvironmentTerminateAction,
+		actions.NewTorqueEnvironmentReleaseAction,
+		actions.NewTorqueEnvironmentReconcileAction,
+		actions.NewTorqueEnvironmentRunWorkflowAction,
+		actions.NewTorqueApprovalRequestDecisionAction,
 	}
 }
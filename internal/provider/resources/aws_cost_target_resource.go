@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -89,7 +90,14 @@ func (r *TorqueAwsCostTargetResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	err := r.client.AddAWSCostTarget(data.Name.ValueString(), "aws", data.RoleArn.ValueString(), data.ExternalId.ValueString())
+	err := r.client.AddCostTarget(client.CostTarget{
+		Name: data.Name.ValueString(),
+		Type: client.CostTargetTypeAws,
+		AwsCostTargetDetails: client.AwsCostTargetDetails{
+			ARN:        data.RoleArn.ValueString(),
+			ExternalId: data.ExternalId.ValueString(),
+		},
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AWS cost collection target, got error: %s", err))
@@ -112,6 +120,28 @@ func (r *TorqueAwsCostTargetResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	target, err := r.client.GetCostTarget(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			tflog.Warn(ctx, "AWS cost target not found in Torque, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AWS cost collection target, got error: %s", err))
+		return
+	}
+	// A target with the same name that collects the cost of another cloud is not managed by this resource.
+	if target.Type != client.CostTargetTypeAws {
+		tflog.Warn(ctx, "Cost target is no longer an AWS cost target, removing from state", map[string]any{"type": target.Type})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(target.Name)
+	data.RoleArn = types.StringValue(target.ARN)
+	data.ExternalId = types.StringValue(target.ExternalId)
+
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -127,7 +157,14 @@ func (r *TorqueAwsCostTargetResource) Update(ctx context.Context, req resource.U
 		return
 	}
 	current_target_name := state.Name
-	err := r.client.UpdateAWSCostTarget(current_target_name.ValueString(), data.Name.ValueString(), "aws", data.RoleArn.ValueString(), data.ExternalId.ValueString())
+	err := r.client.UpdateCostTarget(current_target_name.ValueString(), client.CostTarget{
+		NewName: data.Name.ValueString(),
+		Type:    client.CostTargetTypeAws,
+		AwsCostTargetDetails: client.AwsCostTargetDetails{
+			ARN:        data.RoleArn.ValueString(),
+			ExternalId: data.ExternalId.ValueString(),
+		},
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating AWS Cost Target",
@@ -0,0 +1,232 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueAzureCostTargetResource{}
+var _ resource.ResourceWithImportState = &TorqueAzureCostTargetResource{}
+
+func NewTorqueAzureCostTargetResource() resource.Resource {
+	return &TorqueAzureCostTargetResource{}
+}
+
+// TorqueAzureCostTargetResource defines the resource implementation.
+type TorqueAzureCostTargetResource struct {
+	client *client.Client
+}
+
+// TorqueAzureCostTargetResourceModel describes the resource data model.
+type TorqueAzureCostTargetResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	TenantId         types.String `tfsdk:"tenant_id"`
+	SubscriptionId   types.String `tfsdk:"subscription_id"`
+	ClientId         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+	StorageAccount   types.String `tfsdk:"storage_account"`
+	StorageContainer types.String `tfsdk:"storage_container"`
+	ExportDirectory  types.String `tfsdk:"export_directory"`
+}
+
+func (r *TorqueAzureCostTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_azure_cost_target"
+}
+
+func (r *TorqueAzureCostTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creation of a new Azure Cost Collection target in Torque account. Cost is collected from an Azure Cost Management export to a storage account.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the new cost collection target to be added to torque",
+				Required:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The Azure tenant id of the app registration used to read the cost export",
+				Required:            true,
+			},
+			"subscription_id": schema.StringAttribute{
+				MarkdownDescription: "The Azure subscription id to collect the cost of",
+				Required:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client id of the app registration used to read the cost export",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the app registration used to read the cost export. The secret is not returned by Torque, so changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"storage_account": schema.StringAttribute{
+				MarkdownDescription: "Name of the storage account the cost export is written to",
+				Required:            true,
+			},
+			"storage_container": schema.StringAttribute{
+				MarkdownDescription: "Name of the storage container the cost export is written to",
+				Required:            true,
+			},
+			"export_directory": schema.StringAttribute{
+				MarkdownDescription: "Directory in the storage container the cost export is written to. For more infrormation: https://docs.qtorque.io/governance/cost-tracking/configuring-cost-azure",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *TorqueAzureCostTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueAzureCostTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueAzureCostTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddCostTarget(client.CostTarget{
+		Name:                   data.Name.ValueString(),
+		Type:                   client.CostTargetTypeAzure,
+		AzureCostTargetDetails: azureCostTargetDetails(data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Azure cost collection target, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureCostTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueAzureCostTargetResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.GetCostTarget(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Azure cost collection target, got error: %s", err))
+		return
+	}
+	// A target with the same name that collects the cost of another cloud is not managed by this resource.
+	if target.Type != client.CostTargetTypeAzure {
+		tflog.Warn(ctx, "Cost target is no longer an Azure cost target, removing from state", map[string]any{"type": target.Type})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(target.Name)
+	data.TenantId = types.StringValue(target.TenantId)
+	data.SubscriptionId = types.StringValue(target.SubscriptionId)
+	data.ClientId = types.StringValue(target.ClientId)
+	data.StorageAccount = types.StringValue(target.StorageAccount)
+	data.StorageContainer = types.StringValue(target.StorageContainer)
+	if !data.ExportDirectory.IsNull() || target.ExportDirectory != "" {
+		data.ExportDirectory = types.StringValue(target.ExportDirectory)
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureCostTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TorqueAzureCostTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateCostTarget(state.Name.ValueString(), client.CostTarget{
+		NewName:                data.Name.ValueString(),
+		Type:                   client.CostTargetTypeAzure,
+		AzureCostTargetDetails: azureCostTargetDetails(data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Azure Cost Target",
+			"Could not update Azure Cost Target, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureCostTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueAzureCostTargetResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCostTarget(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Azure cost collection target, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueAzureCostTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func azureCostTargetDetails(data TorqueAzureCostTargetResourceModel) client.AzureCostTargetDetails {
+	return client.AzureCostTargetDetails{
+		TenantId:         data.TenantId.ValueString(),
+		SubscriptionId:   data.SubscriptionId.ValueString(),
+		ClientId:         data.ClientId.ValueString(),
+		ClientSecret:     data.ClientSecret.ValueString(),
+		StorageAccount:   data.StorageAccount.ValueString(),
+		StorageContainer: data.StorageContainer.ValueString(),
+		ExportDirectory:  data.ExportDirectory.ValueString(),
+	}
+}
@@ -0,0 +1,209 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueGcpCostTargetResource{}
+var _ resource.ResourceWithImportState = &TorqueGcpCostTargetResource{}
+
+func NewTorqueGcpCostTargetResource() resource.Resource {
+	return &TorqueGcpCostTargetResource{}
+}
+
+// TorqueGcpCostTargetResource defines the resource implementation.
+type TorqueGcpCostTargetResource struct {
+	client *client.Client
+}
+
+// TorqueGcpCostTargetResourceModel describes the resource data model.
+type TorqueGcpCostTargetResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	BillingDataset    types.String `tfsdk:"billing_dataset"`
+	BillingTable      types.String `tfsdk:"billing_table"`
+	ServiceAccountKey types.String `tfsdk:"service_account_key"`
+}
+
+func (r *TorqueGcpCostTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_gcp_cost_target"
+}
+
+func (r *TorqueGcpCostTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creation of a new GCP Cost Collection target in Torque account. Cost is collected from the Cloud Billing export to BigQuery.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the new cost collection target to be added to torque",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the GCP project that holds the billing export dataset",
+				Required:            true,
+			},
+			"billing_dataset": schema.StringAttribute{
+				MarkdownDescription: "Name of the BigQuery dataset the Cloud Billing data is exported to",
+				Required:            true,
+			},
+			"billing_table": schema.StringAttribute{
+				MarkdownDescription: "Name of the BigQuery table the Cloud Billing data is exported to. For example, gcp_billing_export_v1_XXXXXX_XXXXXX_XXXXXX",
+				Required:            true,
+			},
+			"service_account_key": schema.StringAttribute{
+				MarkdownDescription: "JSON key of a service account with permission to query the billing export dataset. The key is not returned by Torque, so changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *TorqueGcpCostTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueGcpCostTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueGcpCostTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddCostTarget(client.CostTarget{
+		Name:                 data.Name.ValueString(),
+		Type:                 client.CostTargetTypeGcp,
+		GcpCostTargetDetails: gcpCostTargetDetails(data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GCP cost collection target, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueGcpCostTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueGcpCostTargetResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.GetCostTarget(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GCP cost collection target, got error: %s", err))
+		return
+	}
+	// A target with the same name that collects the cost of another cloud is not managed by this resource.
+	if target.Type != client.CostTargetTypeGcp {
+		tflog.Warn(ctx, "Cost target is no longer an GCP cost target, removing from state", map[string]any{"type": target.Type})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(target.Name)
+	data.ProjectId = types.StringValue(target.ProjectId)
+	data.BillingDataset = types.StringValue(target.BillingDataset)
+	data.BillingTable = types.StringValue(target.BillingTable)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueGcpCostTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TorqueGcpCostTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateCostTarget(state.Name.ValueString(), client.CostTarget{
+		NewName:              data.Name.ValueString(),
+		Type:                 client.CostTargetTypeGcp,
+		GcpCostTargetDetails: gcpCostTargetDetails(data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating GCP Cost Target",
+			"Could not update GCP Cost Target, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueGcpCostTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueGcpCostTargetResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCostTarget(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete GCP cost collection target, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueGcpCostTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func gcpCostTargetDetails(data TorqueGcpCostTargetResourceModel) client.GcpCostTargetDetails {
+	return client.GcpCostTargetDetails{
+		ProjectId:         data.ProjectId.ValueString(),
+		BillingDataset:    data.BillingDataset.ValueString(),
+		BillingTable:      data.BillingTable.ValueString(),
+		ServiceAccountKey: data.ServiceAccountKey.ValueString(),
+	}
+}
@@ -2,14 +2,22 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -22,6 +30,7 @@ func NewTorqueIntrospectionResource() resource.Resource {
 
 // TorqueIntrospectionResource defines the resource implementation.
 type TorqueIntrospectionResource struct {
+	client *client.Client
 }
 
 // TorqueIntrospectionResourceModel describes the resource data model.
@@ -30,6 +39,23 @@ type TorqueIntrospectionResourceModel struct {
 	Image             types.String `tfsdk:"image"`
 	IntrospectionData types.Map    `tfsdk:"introspection_data"`
 	Links             types.List   `tfsdk:"links"`
+	SpaceName         types.String `tfsdk:"space_name"`
+	EnvironmentId     types.String `tfsdk:"environment_id"`
+	GrainPath         types.String `tfsdk:"grain_path"`
+}
+
+type introspectionLinkModel struct {
+	Icon  types.String `tfsdk:"icon"`
+	Href  types.String `tfsdk:"href"`
+	Label types.String `tfsdk:"label"`
+	Color types.String `tfsdk:"color"`
+}
+
+var introspectionLinkAttrTypes = map[string]attr.Type{
+	"icon":  types.StringType,
+	"href":  types.StringType,
+	"label": types.StringType,
+	"color": types.StringType,
 }
 
 func (r *TorqueIntrospectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,7 +65,9 @@ func (r *TorqueIntrospectionResource) Metadata(ctx context.Context, req resource
 func (r *TorqueIntrospectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Resource that will be presented in Torque resource catalog",
+		MarkdownDescription: `Resource that will be presented in Torque resource catalog.
+
+		When used in a Terraform grain, the resource card is picked up by Torque from the grain state. When environment_id and grain_path are set, the resource card is published to the environment through the Torque API, and changes made outside of Terraform are detected. Published resource cards can be imported using ` + "`<space_name>/<environment_id>/<grain_path>/<display_name>`" + `.`,
 
 		Attributes: map[string]schema.Attribute{
 			"display_name": schema.StringAttribute{
@@ -86,6 +114,33 @@ func (r *TorqueIntrospectionResource) Schema(ctx context.Context, req resource.S
 					},
 				},
 			},
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Space of the environment to publish the resource card to. If omitted, the provider space is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to publish the resource card to",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("grain_path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grain_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the environment grain the resource card belongs to",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("environment_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -96,6 +151,18 @@ func (r *TorqueIntrospectionResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
 }
 
 func (r *TorqueIntrospectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -108,20 +175,13 @@ func (r *TorqueIntrospectionResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create example, got error: %s", err))
-	//     return
-	// }
-
-	// For the purposes of this example code, hardcoding a response value to
-	// save into the Terraform state.
-	//data.Id = types.StringValue("example-id")
+	if r.isPublished(data) {
+		resp.Diagnostics.Append(r.publish(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	// Write logs using the tflog package
-	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -138,13 +198,59 @@ func (r *TorqueIntrospectionResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	if !r.isPublished(data) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	items, err := r.client.GetIntrospectionDetails(r.spaceName(data), data.EnvironmentId.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read introspection of environment '%s', got error: %s", data.EnvironmentId.ValueString(), err))
+		return
+	}
+	index := slices.IndexFunc(items, func(item client.IntrospectionItem) bool {
+		return item.GrainPath == data.GrainPath.ValueString() && item.ResourceName == data.DisplayName.ValueString()
+	})
+	if index < 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	item := items[index]
+	if !data.Image.IsNull() || item.CustomIcon != "" {
+		data.Image = types.StringValue(item.CustomIcon)
+	}
+	if !data.IntrospectionData.IsNull() || len(item.Attributes) > 0 {
+		attributes := make(map[string]string)
+		for _, attribute := range item.Attributes {
+			attributes[attribute.Name] = attribute.Value
+		}
+		var diags diag.Diagnostics
+		data.IntrospectionData, diags = types.MapValueFrom(ctx, types.StringType, attributes)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !data.Links.IsNull() || len(item.Links) > 0 {
+		links := []introspectionLinkModel{}
+		for _, link := range item.Links {
+			color := types.StringNull()
+			if link.Color != "" {
+				color = types.StringValue(link.Color)
+			}
+			links = append(links, introspectionLinkModel{
+				Icon:  types.StringValue(link.Icon),
+				Href:  types.StringValue(link.Href),
+				Label: types.StringValue(link.Label),
+				Color: color,
+			})
+		}
+		var diags diag.Diagnostics
+		data.Links, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: introspectionLinkAttrTypes}, links)
+		resp.Diagnostics.Append(diags...)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -152,21 +258,30 @@ func (r *TorqueIntrospectionResource) Read(ctx context.Context, req resource.Rea
 
 func (r *TorqueIntrospectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data TorqueIntrospectionResourceModel
+	var state TorqueIntrospectionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	if r.isPublished(data) {
+		resp.Diagnostics.Append(r.publish(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The display name identifies the resource card, so the card is renamed by removing the previous one.
+		if data.DisplayName.ValueString() != state.DisplayName.ValueString() {
+			err := r.client.DeleteIntrospectionResource(r.spaceName(state), state.EnvironmentId.ValueString(), state.GrainPath.ValueString(), state.DisplayName.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove introspection resource '%s', got error: %s", state.DisplayName.ValueString(), err))
+				return
+			}
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -182,15 +297,86 @@ func (r *TorqueIntrospectionResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-	//     return
-	// }.
+	if !r.isPublished(data) {
+		return
+	}
+	err := r.client.DeleteIntrospectionResource(r.spaceName(data), data.EnvironmentId.ValueString(), data.GrainPath.ValueString(), data.DisplayName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete introspection resource '%s', got error: %s", data.DisplayName.ValueString(), err))
+		return
+	}
 }
 
 func (r *TorqueIntrospectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("display_name"), req, resp)
+	// The display name may contain slashes, it is everything after the grain path.
+	parts := strings.SplitN(req.ID, "/", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <space_name>/<environment_id>/<grain_path>/<display_name>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("grain_path"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("display_name"), parts[3])...)
+}
+
+// isPublished reports whether the resource card is published to an environment through the
+// Torque API, rather than picked up by Torque from the state of the grain it is defined in.
+func (r *TorqueIntrospectionResource) isPublished(data TorqueIntrospectionResourceModel) bool {
+	return !data.EnvironmentId.IsNull() && r.client != nil
+}
+
+func (r *TorqueIntrospectionResource) spaceName(data TorqueIntrospectionResourceModel) string {
+	if data.SpaceName.IsNull() {
+		return r.client.Space
+	}
+	return data.SpaceName.ValueString()
+}
+
+func (r *TorqueIntrospectionResource) publish(ctx context.Context, data TorqueIntrospectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributes := make(map[string]string)
+	if !data.IntrospectionData.IsNull() {
+		diags.Append(data.IntrospectionData.ElementsAs(ctx, &attributes, false)...)
+	}
+	links := []introspectionLinkModel{}
+	if !data.Links.IsNull() {
+		diags.Append(data.Links.ElementsAs(ctx, &links, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	introspection_resource := client.CustomIntrospectionResource{
+		GrainPath:  data.GrainPath.ValueString(),
+		Name:       data.DisplayName.ValueString(),
+		Image:      data.Image.ValueString(),
+		Attributes: []client.NameValuePair{},
+		Links:      []client.IntrospectionLinkButton{},
+	}
+	// Only the first attributes are presented in the resource card, so they are sent in a stable order.
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		introspection_resource.Attributes = append(introspection_resource.Attributes, client.NameValuePair{Name: name, Value: attributes[name]})
+	}
+	for _, link := range links {
+		introspection_resource.Links = append(introspection_resource.Links, client.IntrospectionLinkButton{
+			Icon:  link.Icon.ValueString(),
+			Href:  link.Href.ValueString(),
+			Label: link.Label.ValueString(),
+			Color: link.Color.ValueString(),
+		})
+	}
+	err := r.client.PublishIntrospectionResource(r.spaceName(data), data.EnvironmentId.ValueString(), introspection_resource)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to publish introspection resource '%s' to environment '%s', got error: %s", data.DisplayName.ValueString(), data.EnvironmentId.ValueString(), err))
+	}
+	return diags
 }
@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+type repositoryDiscoveryFolderModel struct {
+	Path    types.String `tfsdk:"path"`
+	Type    types.String `tfsdk:"type"`
+	Branch  types.String `tfsdk:"branch"`
+	Include types.List   `tfsdk:"include"`
+	Exclude types.List   `tfsdk:"exclude"`
+}
+
+var repositoryDiscoveryFolderAttrTypes = map[string]attr.Type{
+	"path":    types.StringType,
+	"type":    types.StringType,
+	"branch":  types.StringType,
+	"include": types.ListType{ElemType: types.StringType},
+	"exclude": types.ListType{ElemType: types.StringType},
+}
+
+// repoDiscoveryAttributes returns the blueprint discovery attributes shared by all repository space association resources.
+func repoDiscoveryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"discovery_folders": schema.ListNestedAttribute{
+			Description: "Folders of the repository Torque scans for blueprints and workflows. When not set, Torque scans the default blueprints and workflows folders of the repository branch.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Description: "Path of the folder in the repository, for example blueprints/networking",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Type of the assets in the folder. One of blueprint or workflow. Default is blueprint.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("blueprint"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("blueprint", "workflow"),
+						},
+					},
+					"branch": schema.StringAttribute{
+						Description: "Branch to discover the folder from. Defaults to the branch of the repository.",
+						Optional:    true,
+					},
+					"include": schema.ListAttribute{
+						Description: "Glob patterns of the files in the folder to discover, for example *.yaml. All files are discovered when not set.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"exclude": schema.ListAttribute{
+						Description: "Glob patterns of the files in the folder to skip, for example drafts/**",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"discovered_blueprints": schema.Int64Attribute{
+			Description: "Number of blueprints Torque discovered in the repository",
+			Computed:    true,
+		},
+	}
+}
+
+// updateRepoDiscovery sends the EaC auto registration and discovery folders of the repository to Torque.
+func updateRepoDiscovery(ctx context.Context, client *client.Client, space_name string, repo_name string, autoRegisterEac types.Bool, folders types.List) diag.Diagnostics {
+	discoveryFolders, diags := repoDiscoveryFolders(ctx, folders)
+	if diags.HasError() {
+		return diags
+	}
+	err := client.UpdateRepoDiscovery(space_name, repo_name, autoRegisterEac.ValueBool(), discoveryFolders)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update repository discovery settings, got error: %s", err))
+	}
+	return diags
+}
+
+// setRepoDiscovery sets the EaC auto registration, discovery folders and discovered blueprints Torque reports for an onboarded repository.
+func setRepoDiscovery(ctx context.Context, repo *client.RepoDetails, autoRegisterEac *types.Bool, folders *types.List, discovered *types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	*autoRegisterEac = types.BoolValue(repo.AutoRegisterEac)
+	*discovered = types.Int64Value(repo.BlueprintsCount)
+	// Keep folders unset when Torque uses the default folders and none were configured.
+	if folders.IsNull() && len(repo.DiscoveryFolders) == 0 {
+		return diags
+	}
+	models := []repositoryDiscoveryFolderModel{}
+	for _, folder := range repo.DiscoveryFolders {
+		model := repositoryDiscoveryFolderModel{
+			Path:    types.StringValue(folder.Path),
+			Type:    types.StringValue(folder.Type),
+			Branch:  types.StringNull(),
+			Include: types.ListNull(types.StringType),
+			Exclude: types.ListNull(types.StringType),
+		}
+		if folder.Branch != "" {
+			model.Branch = types.StringValue(folder.Branch)
+		}
+		var d diag.Diagnostics
+		if len(folder.Include) > 0 {
+			model.Include, d = types.ListValueFrom(ctx, types.StringType, folder.Include)
+			diags.Append(d...)
+		}
+		if len(folder.Exclude) > 0 {
+			model.Exclude, d = types.ListValueFrom(ctx, types.StringType, folder.Exclude)
+			diags.Append(d...)
+		}
+		models = append(models, model)
+	}
+	value, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: repositoryDiscoveryFolderAttrTypes}, models)
+	diags.Append(d...)
+	*folders = value
+	return diags
+}
+
+// repoDiscoveryFolders converts the configured discovery folders to the Torque API model.
+func repoDiscoveryFolders(ctx context.Context, folders types.List) ([]client.RepoDiscoveryFolder, diag.Diagnostics) {
+	discoveryFolders := []client.RepoDiscoveryFolder{}
+	if folders.IsNull() || folders.IsUnknown() {
+		return discoveryFolders, nil
+	}
+	models := []repositoryDiscoveryFolderModel{}
+	diags := folders.ElementsAs(ctx, &models, false)
+	for _, model := range models {
+		folder := client.RepoDiscoveryFolder{
+			Path:    model.Path.ValueString(),
+			Type:    model.Type.ValueString(),
+			Branch:  model.Branch.ValueString(),
+			Include: []string{},
+			Exclude: []string{},
+		}
+		if !model.Include.IsNull() {
+			diags.Append(model.Include.ElementsAs(ctx, &folder.Include, false)...)
+		}
+		if !model.Exclude.IsNull() {
+			diags.Append(model.Exclude.ElementsAs(ctx, &folder.Exclude, false)...)
+		}
+		discoveryFolders = append(discoveryFolders, folder)
+	}
+	return discoveryFolders, diags
+}
@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// repoSyncAttributes returns the sync status attributes shared by all repository space association resources.
+func repoSyncAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status": schema.StringAttribute{
+			Description: "Sync status of the repository, for example Connected or Syncing",
+			Computed:    true,
+		},
+		"errors": schema.ListAttribute{
+			Description: "Errors reported by Torque while syncing the repository",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"last_synced_commit": schema.StringAttribute{
+			Description: "The last commit of the repository branch synced by Torque",
+			Computed:    true,
+		},
+		"resync_trigger": schema.StringAttribute{
+			Description: "Arbitrary value that, when changed, makes Torque rescan the repository so new blueprints and assets appear in the space",
+			Optional:    true,
+		},
+	}
+}
+
+// setRepoSyncStatus sets the sync status, errors and last synced commit Torque reports for an onboarded repository.
+func setRepoSyncStatus(ctx context.Context, repo *client.RepoDetails, status *types.String, errors *types.List, lastSyncedCommit *types.String) diag.Diagnostics {
+	messages := []string{}
+	for _, repoError := range repo.Errors {
+		messages = append(messages, repoError.Message)
+	}
+	var diags diag.Diagnostics
+	*status = types.StringValue(repo.Status)
+	*lastSyncedCommit = types.StringValue(repo.LastSyncedCommit)
+	*errors, diags = types.ListValueFrom(ctx, types.StringType, messages)
+	return diags
+}
+
+// readRepoSyncStatus fetches the repository from Torque and sets its sync status and the number of blueprints discovered in it.
+func readRepoSyncStatus(ctx context.Context, client *client.Client, space_name string, repo_name string, status *types.String, errors *types.List, lastSyncedCommit *types.String, discovered *types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	repo, err := client.GetRepoDetails(space_name, repo_name)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read repository status, got error: %s", err))
+		return diags
+	}
+	diags.Append(setRepoSyncStatus(ctx, repo, status, errors, lastSyncedCommit)...)
+	*discovered = types.Int64Value(repo.BlueprintsCount)
+	return diags
+}
+
+// clearRepoStatus unsets the status attributes of a repository until they are read from Torque.
+func clearRepoStatus(status *types.String, errors *types.List, lastSyncedCommit *types.String, discovered *types.Int64) {
+	*status = types.StringNull()
+	*errors = types.ListNull(types.StringType)
+	*lastSyncedCommit = types.StringNull()
+	*discovered = types.Int64Null()
+}
+
+// readOnboardedRepoStatus reads the status of a repository right after it was onboarded. The repository is
+// already saved in the state by then, so a failure to read it is reported as a warning and the status is
+// read again on the next refresh.
+func readOnboardedRepoStatus(ctx context.Context, client *client.Client, space_name string, repo_name string, status *types.String, errors *types.List, lastSyncedCommit *types.String, discovered *types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	repo, err := client.GetRepoDetails(space_name, repo_name)
+	if err != nil {
+		diags.AddWarning("Unable to read repository status", fmt.Sprintf("The repository was onboarded, its status will be read on the next refresh. Got error: %s", err))
+		return diags
+	}
+	diags.Append(setRepoSyncStatus(ctx, repo, status, errors, lastSyncedCommit)...)
+	*discovered = types.Int64Value(repo.BlueprintsCount)
+	return diags
+}
+
+// resyncRepo asks Torque to rescan the repository when the resync trigger changed.
+func resyncRepo(client *client.Client, space_name string, repo_name string, trigger types.String, prior types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if trigger.Equal(prior) {
+		return diags
+	}
+	err := client.SyncRepo(space_name, repo_name)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to resync repository, got error: %s", err))
+	}
+	return diags
+}
+
+// waitForRepoSync polls the repository until Torque finishes syncing it or the timeout expires.
+func waitForRepoSync(client *client.Client, space_name string, repo_name string, timeout time.Duration) (*client.RepoDetails, error) {
+	const (
+		StatusSyncing = "Syncing"
+		Interval      = 4 * time.Second
+	)
+	start := time.Now()
+	for {
+		repo, err := client.GetRepoDetails(space_name, repo_name)
+		if err != nil {
+			return nil, err
+		}
+		if repo.Status != StatusSyncing {
+			return repo, nil
+		}
+		if time.Since(start) >= timeout {
+			return nil, fmt.Errorf("timed out while syncing repository %s", repo_name)
+		}
+		time.Sleep(Interval)
+	}
+}
+
+// importRepoState imports a repository space association from a "<space_name>/<repository_name>" id.
+func importRepoState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	space_name, repo_name, found := strings.Cut(req.ID, "/")
+	if !found || space_name == "" || repo_name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <space_name>/<repository_name>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_name"), space_name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_name"), repo_name)...)
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -33,16 +34,22 @@ type TorqueSpaceAdoServerRepositoryResource struct {
 }
 
 type TorqueSpaceAdoServerRepositoryResourceModel struct {
-	SpaceName       types.String `tfsdk:"space_name"`
-	RepositoryName  types.String `tfsdk:"repository_name"`
-	RepositoryUrl   types.String `tfsdk:"repository_url"`
-	Token           types.String `tfsdk:"token"`
-	Branch          types.String `tfsdk:"branch"`
-	CredentialName  types.String `tfsdk:"credential_name"`
-	UseAllAgents    types.Bool   `tfsdk:"use_all_agents"`
-	Agents          types.List   `tfsdk:"agents"`
-	TimeOut         types.Int32  `tfsdk:"timeout"`
-	AutoRegisterEac types.Bool   `tfsdk:"auto_register_eac"`
+	SpaceName            types.String `tfsdk:"space_name"`
+	RepositoryName       types.String `tfsdk:"repository_name"`
+	RepositoryUrl        types.String `tfsdk:"repository_url"`
+	Token                types.String `tfsdk:"token"`
+	Branch               types.String `tfsdk:"branch"`
+	CredentialName       types.String `tfsdk:"credential_name"`
+	UseAllAgents         types.Bool   `tfsdk:"use_all_agents"`
+	Agents               types.List   `tfsdk:"agents"`
+	TimeOut              types.Int32  `tfsdk:"timeout"`
+	AutoRegisterEac      types.Bool   `tfsdk:"auto_register_eac"`
+	Status               types.String `tfsdk:"status"`
+	Errors               types.List   `tfsdk:"errors"`
+	LastCommit           types.String `tfsdk:"last_synced_commit"`
+	ResyncTrigger        types.String `tfsdk:"resync_trigger"`
+	DiscoveryFolders     types.List   `tfsdk:"discovery_folders"`
+	DiscoveredBlueprints types.Int64  `tfsdk:"discovered_blueprints"`
 }
 
 func (r *TorqueSpaceAdoServerRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,6 +130,8 @@ func (r *TorqueSpaceAdoServerRepositoryResource) Schema(ctx context.Context, req
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, repoSyncAttributes())
+	maps.Copy(resp.Schema.Attributes, repoDiscoveryAttributes())
 }
 
 func (r *TorqueSpaceAdoServerRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -185,6 +194,8 @@ func (r *TorqueSpaceAdoServerRepositoryResource) Create(ctx context.Context, req
 					return
 				}
 				if repo.Status == StatusConnected {
+					resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+					data.DiscoveredBlueprints = types.Int64Value(repo.BlueprintsCount)
 					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 					return
 				}
@@ -196,6 +207,19 @@ func (r *TorqueSpaceAdoServerRepositoryResource) Create(ctx context.Context, req
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to onboard repository to space, got error: %s", onboardErr))
 		return
 	}
+	// Save the onboarded repository right away, so it stays tracked by Terraform if a later step fails.
+	clearRepoStatus(&data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DiscoveryFolders.IsNull() {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(readOnboardedRepoStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -209,18 +233,29 @@ func (r *TorqueSpaceAdoServerRepositoryResource) Read(ctx context.Context, req r
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
+	repo, err := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		// The repository was removed from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	resp.Diagnostics.Append(setRepoDiscovery(ctx, repo, &data.AutoRegisterEac, &data.DiscoveryFolders, &data.DiscoveredBlueprints)...)
 
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TorqueSpaceAdoServerRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TorqueSpaceAdoServerRepositoryResourceModel
+	var data, state TorqueSpaceAdoServerRepositoryResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -237,6 +272,17 @@ func (r *TorqueSpaceAdoServerRepositoryResource) Update(ctx context.Context, req
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository configuration, got error: %s", err))
 		return
 	}
+	if !data.AutoRegisterEac.Equal(state.AutoRegisterEac) || !data.DiscoveryFolders.Equal(state.DiscoveryFolders) {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resyncRepo(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.ResyncTrigger, state.ResyncTrigger)...)
+	resp.Diagnostics.Append(readRepoSyncStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,323 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+	"github.com/qualitorque/terraform-provider-torque/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueSpaceAgentRepositoryResource{}
+var _ resource.ResourceWithImportState = &TorqueSpaceAgentRepositoryResource{}
+
+func NewTorqueSpaceGithubEnterpriseRepositoryResource() resource.Resource {
+	return &TorqueSpaceAgentRepositoryResource{
+		repoType:   "github_enterprise",
+		vendor:     "GitHub Enterprise Server",
+		urlExample: "https://github-on-prem.example.com/org/repo_name",
+	}
+}
+
+func NewTorqueSpaceBitbucketServerRepositoryResource() resource.Resource {
+	return &TorqueSpaceAgentRepositoryResource{
+		repoType:   "bitbucket_server",
+		vendor:     "Bitbucket Server",
+		urlExample: "https://bitbucket-on-prem.example.com/scm/project/repo_name.git",
+	}
+}
+
+// TorqueSpaceAgentRepositoryResource defines the resource implementation for repositories that Torque
+// accesses through the agents associated with the space, one resource type per repository type.
+type TorqueSpaceAgentRepositoryResource struct {
+	client     *client.Client
+	repoType   string
+	vendor     string
+	urlExample string
+}
+
+type TorqueSpaceAgentRepositoryResourceModel struct {
+	SpaceName            types.String `tfsdk:"space_name"`
+	RepositoryName       types.String `tfsdk:"repository_name"`
+	RepositoryUrl        types.String `tfsdk:"repository_url"`
+	Branch               types.String `tfsdk:"branch"`
+	CredentialName       types.String `tfsdk:"credential_name"`
+	UseAllAgents         types.Bool   `tfsdk:"use_all_agents"`
+	Agents               types.List   `tfsdk:"agents"`
+	TimeOut              types.Int32  `tfsdk:"timeout"`
+	AutoRegisterEac      types.Bool   `tfsdk:"auto_register_eac"`
+	Status               types.String `tfsdk:"status"`
+	Errors               types.List   `tfsdk:"errors"`
+	LastCommit           types.String `tfsdk:"last_synced_commit"`
+	ResyncTrigger        types.String `tfsdk:"resync_trigger"`
+	DiscoveryFolders     types.List   `tfsdk:"discovery_folders"`
+	DiscoveredBlueprints types.Int64  `tfsdk:"discovered_blueprints"`
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("torque_%s_repository_space_association", r.repoType)
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Onboard a new %s repository into an existing space. The repository is accessed through the agents associated with the space. Can be imported using `<space_name>/<repository_name>`.", r.vendor),
+
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Existing Torque Space name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				Description: fmt.Sprintf("The name of the %s repository to onboard. In this example, repo_name", r.vendor),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_url": schema.StringAttribute{
+				Description: fmt.Sprintf("The url of the specific %s repository to onboard. For example: %s", r.vendor, r.urlExample),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Description: "Repository branch to use for blueprints and automation assets",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credential_name": schema.StringAttribute{
+				Description: "The name of the existing Credentials to use.",
+				Required:    true,
+			},
+			"use_all_agents": schema.BoolAttribute{
+				Description: "Whether all associated agents can be used to onboard and sync this repository. Must be set to false if agents attribute is used.",
+				Default:     booldefault.StaticBool(true),
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.Bool{validators.UseAllAgentsValidator{}},
+			},
+			"agents": schema.ListAttribute{
+				Description: "List of specific agents to use to onboard and sync this repository. Cannot be specified when use_all_agents is true.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"timeout": schema.Int32Attribute{
+				Description: "Time in minutes to wait for Torque to sync the repository during the onboarding. Default is 1 minute.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(1),
+			},
+			"auto_register_eac": schema.BoolAttribute{
+				Description: "Auto register environment files",
+				Default:     booldefault.StaticBool(false),
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, repoSyncAttributes())
+	maps.Copy(resp.Schema.Attributes, repoDiscoveryAttributes())
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueSpaceAgentRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	agents := []string{}
+	resp.Diagnostics.Append(data.Agents.ElementsAs(ctx, &agents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	const StatusSyncing = "Syncing"
+	err := r.client.OnboardRepositoryToSpace(data.SpaceName.ValueString(), client.RepositoryOnboarding{
+		Name:            data.RepositoryName.ValueString(),
+		URL:             data.RepositoryUrl.ValueString(),
+		Type:            r.repoType,
+		Branch:          data.Branch.ValueString(),
+		CredentialName:  data.CredentialName.ValueString(),
+		UseAllAgents:    data.UseAllAgents.ValueBoolPointer(),
+		Agents:          agents,
+		AutoRegisterEac: data.AutoRegisterEac.ValueBool(),
+	})
+	if err != nil {
+		// Onboarding fails while the agent is still syncing the repository, in that case wait for the sync to complete.
+		repo, _ := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+		if repo == nil || repo.Status != StatusSyncing {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to onboard repository to space, got error: %s", err))
+			return
+		}
+	}
+	// Save the onboarded repository right away, so it stays tracked by Terraform if a later step fails.
+	clearRepoStatus(&data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DiscoveryFolders.IsNull() {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	timeout := time.Duration(data.TimeOut.ValueInt32()) * time.Minute
+	repo, err := waitForRepoSync(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Sync Timeout", fmt.Sprintf("Error while syncing repository: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	data.DiscoveredBlueprints = types.Int64Value(repo.BlueprintsCount)
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueSpaceAgentRepositoryResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		// The repository was removed from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+	data.RepositoryUrl = types.StringValue(repo.URL)
+	data.Branch = types.StringValue(repo.Branch)
+	data.CredentialName = types.StringValue(repo.CredentialName)
+	data.UseAllAgents = types.BoolValue(repo.UseAllAgents)
+	if !repo.UseAllAgents {
+		agents := []string{}
+		for _, agent := range repo.Agents {
+			agents = append(agents, agent.Name)
+		}
+		var diags diag.Diagnostics
+		data.Agents, diags = types.ListValueFrom(ctx, types.StringType, agents)
+		resp.Diagnostics.Append(diags...)
+	}
+	// Imported repositories have no timeout or auto registration in the state yet.
+	if data.TimeOut.IsNull() {
+		data.TimeOut = types.Int32Value(1)
+	}
+	if data.AutoRegisterEac.IsNull() {
+		data.AutoRegisterEac = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	resp.Diagnostics.Append(setRepoDiscovery(ctx, repo, &data.AutoRegisterEac, &data.DiscoveryFolders, &data.DiscoveredBlueprints)...)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TorqueSpaceAgentRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	agents := []string{}
+	resp.Diagnostics.Append(data.Agents.ElementsAs(ctx, &agents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateRepoConfiguration(data.SpaceName.ValueString(), data.RepositoryName.ValueString(),
+		data.CredentialName.ValueString(), agents, data.UseAllAgents.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository configuration, got error: %s", err))
+		return
+	}
+	if !data.AutoRegisterEac.Equal(state.AutoRegisterEac) || !data.DiscoveryFolders.Equal(state.DiscoveryFolders) {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resyncRepo(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.ResyncTrigger, state.ResyncTrigger)...)
+	resp.Diagnostics.Append(readRepoSyncStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueSpaceAgentRepositoryResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove repo from space.
+	err := r.client.RemoveRepoFromSpace(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove repository from space, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueSpaceAgentRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRepoState(ctx, req, resp)
+}
@@ -3,10 +3,13 @@ package resources
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,16 +31,23 @@ type TorqueSpaceCodeCommitRepositoryResource struct {
 }
 
 type TorqueSpaceCodeCommitRepositoryResourceModel struct {
-	SpaceName      types.String `tfsdk:"space_name"`
-	RepositoryUrl  types.String `tfsdk:"repository_url"`
-	RoleArn        types.String `tfsdk:"role_arn"`
-	AwsRegion      types.String `tfsdk:"aws_region"`
-	ExternalId     types.String `tfsdk:"external_id"`
-	Username       types.String `tfsdk:"git_username"`
-	Password       types.String `tfsdk:"git_password"`
-	Branch         types.String `tfsdk:"branch"`
-	RepositoryName types.String `tfsdk:"repository_name"`
-	CredentialName types.String `tfsdk:"credential_name"`
+	SpaceName            types.String `tfsdk:"space_name"`
+	RepositoryUrl        types.String `tfsdk:"repository_url"`
+	RoleArn              types.String `tfsdk:"role_arn"`
+	AwsRegion            types.String `tfsdk:"aws_region"`
+	ExternalId           types.String `tfsdk:"external_id"`
+	Username             types.String `tfsdk:"git_username"`
+	Password             types.String `tfsdk:"git_password"`
+	Branch               types.String `tfsdk:"branch"`
+	RepositoryName       types.String `tfsdk:"repository_name"`
+	CredentialName       types.String `tfsdk:"credential_name"`
+	Status               types.String `tfsdk:"status"`
+	Errors               types.List   `tfsdk:"errors"`
+	LastCommit           types.String `tfsdk:"last_synced_commit"`
+	ResyncTrigger        types.String `tfsdk:"resync_trigger"`
+	AutoRegisterEac      types.Bool   `tfsdk:"auto_register_eac"`
+	DiscoveryFolders     types.List   `tfsdk:"discovery_folders"`
+	DiscoveredBlueprints types.Int64  `tfsdk:"discovered_blueprints"`
 }
 
 func (r *TorqueSpaceCodeCommitRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -119,8 +129,16 @@ func (r *TorqueSpaceCodeCommitRepositoryResource) Schema(ctx context.Context, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"auto_register_eac": schema.BoolAttribute{
+				Description: "Auto register environment files",
+				Default:     booldefault.StaticBool(false),
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, repoSyncAttributes())
+	maps.Copy(resp.Schema.Attributes, repoDiscoveryAttributes())
 }
 
 func (r *TorqueSpaceCodeCommitRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -153,11 +171,24 @@ func (r *TorqueSpaceCodeCommitRepositoryResource) Create(ctx context.Context, re
 	}
 
 	err := r.client.OnboardCodeCommitRepoToSpace(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.RoleArn.ValueString(),
-		data.RepositoryUrl.ValueString(), data.AwsRegion.ValueString(), data.Branch.ValueString(), data.ExternalId.ValueString(), data.Username.ValueString(), data.Password.ValueString(), data.CredentialName.ValueString())
+		data.RepositoryUrl.ValueString(), data.AwsRegion.ValueString(), data.Branch.ValueString(), data.ExternalId.ValueString(), data.Username.ValueString(), data.Password.ValueString(), data.CredentialName.ValueString(), data.AutoRegisterEac.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to onboard repository to space, got error: %s", err))
 		return
 	}
+	// Save the onboarded repository right away, so it stays tracked by Terraform if a later step fails.
+	clearRepoStatus(&data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DiscoveryFolders.IsNull() {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(readOnboardedRepoStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
 
 	tflog.Trace(ctx, "Resource Created Successful!")
 
@@ -175,38 +206,49 @@ func (r *TorqueSpaceCodeCommitRepositoryResource) Read(ctx context.Context, req
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	repo, err := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		// The repository was removed from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	resp.Diagnostics.Append(setRepoDiscovery(ctx, repo, &data.AutoRegisterEac, &data.DiscoveryFolders, &data.DiscoveredBlueprints)...)
 
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TorqueSpaceCodeCommitRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// var data TorqueSpaceCodeCommitRepositoryResourceModel
+	var data, state TorqueSpaceCodeCommitRepositoryResourceModel
 
-	// // Read Terraform plan data into the model
-	// resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// // If applicable, this is a great opportunity to initialize any necessary
-	// // provider client data and make a call using it.
-	// // httpResp, err := r.client.Do(httpReq)
-	// // if err != nil {
-	// //     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	// //     return
-	// // }
+	// All other arguments require replacement, only the discovery settings and resync trigger are updated in place.
+	if !data.AutoRegisterEac.Equal(state.AutoRegisterEac) || !data.DiscoveryFolders.Equal(state.DiscoveryFolders) {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resyncRepo(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.ResyncTrigger, state.ResyncTrigger)...)
+	resp.Diagnostics.Append(readRepoSyncStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// // Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TorqueSpaceCodeCommitRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
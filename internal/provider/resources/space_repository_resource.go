@@ -3,12 +3,15 @@ package resources
 import (
 	"context"
 	"fmt"
+	"maps"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TorqueSpaceRepositoryResource{}
 var _ resource.ResourceWithImportState = &TorqueSpaceRepositoryResource{}
+var _ resource.ResourceWithValidateConfig = &TorqueSpaceRepositoryResource{}
 
 func NewTorqueSpaceRepositoryResource() resource.Resource {
 	return &TorqueSpaceRepositoryResource{}
@@ -31,13 +35,22 @@ type TorqueSpaceRepositoryResource struct {
 }
 
 type TorqueSpaceRepositoryResourceModel struct {
-	SpaceName      types.String `tfsdk:"space_name"`
-	RepoUrl        types.String `tfsdk:"repository_url"`
-	RepoToken      types.String `tfsdk:"access_token"`
-	RepoType       types.String `tfsdk:"repository_type"`
-	RepoBranch     types.String `tfsdk:"branch"`
-	RepoName       types.String `tfsdk:"repository_name"`
-	CredentialName types.String `tfsdk:"credential_name"`
+	SpaceName            types.String `tfsdk:"space_name"`
+	RepoUrl              types.String `tfsdk:"repository_url"`
+	RepoToken            types.String `tfsdk:"access_token"`
+	RepoType             types.String `tfsdk:"repository_type"`
+	RepoBranch           types.String `tfsdk:"branch"`
+	RepoName             types.String `tfsdk:"repository_name"`
+	CredentialName       types.String `tfsdk:"credential_name"`
+	InstallationId       types.String `tfsdk:"github_app_installation_id"`
+	OAuthName            types.String `tfsdk:"oauth_connection_name"`
+	Status               types.String `tfsdk:"status"`
+	Errors               types.List   `tfsdk:"errors"`
+	LastCommit           types.String `tfsdk:"last_synced_commit"`
+	ResyncTrigger        types.String `tfsdk:"resync_trigger"`
+	AutoRegisterEac      types.Bool   `tfsdk:"auto_register_eac"`
+	DiscoveryFolders     types.List   `tfsdk:"discovery_folders"`
+	DiscoveredBlueprints types.Int64  `tfsdk:"discovered_blueprints"`
 }
 
 func (r *TorqueSpaceRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,6 +87,8 @@ func (r *TorqueSpaceRepositoryResource) Schema(ctx context.Context, req resource
 					// Validate only this attribute or other_attr is configured or neither.
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("credential_name"),
+						path.MatchRoot("github_app_installation_id"),
+						path.MatchRoot("oauth_connection_name"),
 					}...),
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^\S.*$`),
@@ -113,11 +128,58 @@ func (r *TorqueSpaceRepositoryResource) Schema(ctx context.Context, req resource
 					// Validate only this attribute or other_attr is configured or neither.
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("access_token"),
+						path.MatchRoot("github_app_installation_id"),
+						path.MatchRoot("oauth_connection_name"),
 					}...),
 				},
 			},
+			"github_app_installation_id": schema.StringAttribute{
+				Description: "Installation id of the Torque GitHub App in the GitHub organization of the repository. Used to onboard the repository without a personal access token. Only supported for github repositories.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("oauth_connection_name"),
+					}...),
+				},
+			},
+			"oauth_connection_name": schema.StringAttribute{
+				Description: "The name of an OAuth connection already authorized in the Torque account, used to onboard the repository without a personal access token.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_register_eac": schema.BoolAttribute{
+				Description: "Auto register environment files",
+				Default:     booldefault.StaticBool(false),
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, repoSyncAttributes())
+	maps.Copy(resp.Schema.Attributes, repoDiscoveryAttributes())
+}
+
+func (r *TorqueSpaceRepositoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TorqueSpaceRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.InstallationId.IsNull() && !data.RepoType.IsUnknown() && data.RepoType.ValueString() != "github" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("github_app_installation_id"),
+			"Invalid Attribute Combination",
+			"github_app_installation_id can only be used with github repositories.",
+		)
+	}
 }
 
 func (r *TorqueSpaceRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -149,12 +211,31 @@ func (r *TorqueSpaceRepositoryResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.OnboardRepoToSpace(data.SpaceName.ValueString(), data.RepoName.ValueString(), data.RepoType.ValueString(),
-		data.RepoUrl.ValueString(), data.RepoToken.ValueStringPointer(), data.RepoBranch.ValueString(), data.CredentialName.ValueStringPointer())
+	var err error
+	if !data.InstallationId.IsNull() || !data.OAuthName.IsNull() {
+		err = r.client.OnboardRepoToSpaceWithConnection(data.SpaceName.ValueString(), data.RepoName.ValueString(), data.RepoType.ValueString(),
+			data.RepoUrl.ValueString(), data.RepoBranch.ValueString(), data.InstallationId.ValueStringPointer(), data.OAuthName.ValueStringPointer(), data.AutoRegisterEac.ValueBool())
+	} else {
+		err = r.client.OnboardRepoToSpace(data.SpaceName.ValueString(), data.RepoName.ValueString(), data.RepoType.ValueString(),
+			data.RepoUrl.ValueString(), data.RepoToken.ValueStringPointer(), data.RepoBranch.ValueString(), data.CredentialName.ValueStringPointer(), data.AutoRegisterEac.ValueBool())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to onboard repository to space, got error: %s", err))
 		return
 	}
+	// Save the onboarded repository right away, so it stays tracked by Terraform if a later step fails.
+	clearRepoStatus(&data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DiscoveryFolders.IsNull() {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepoName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(readOnboardedRepoStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepoName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
 
 	tflog.Trace(ctx, "Resource Created Successful!")
 
@@ -172,31 +253,50 @@ func (r *TorqueSpaceRepositoryResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	repo, err := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepoName.ValueString())
+	if err != nil {
+		// The repository was removed from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	resp.Diagnostics.Append(setRepoDiscovery(ctx, repo, &data.AutoRegisterEac, &data.DiscoveryFolders, &data.DiscoveredBlueprints)...)
 
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TorqueSpaceRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TorqueSpaceRepositoryResourceModel
+	var data, state TorqueSpaceRepositoryResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.UpdateRepoCredentials(data.SpaceName.ValueString(), data.RepoName.ValueString(), data.CredentialName.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change repository credentials, got error: %s", err))
+	if !data.CredentialName.Equal(state.CredentialName) {
+		err := r.client.UpdateRepoCredentials(data.SpaceName.ValueString(), data.RepoName.ValueString(), data.CredentialName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change repository credentials, got error: %s", err))
+			return
+		}
+	}
+	if !data.AutoRegisterEac.Equal(state.AutoRegisterEac) || !data.DiscoveryFolders.Equal(state.DiscoveryFolders) {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepoName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resyncRepo(r.client, data.SpaceName.ValueString(), data.RepoName.ValueString(), data.ResyncTrigger, state.ResyncTrigger)...)
+	resp.Diagnostics.Append(readRepoSyncStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepoName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -0,0 +1,615 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueSpaceRepositoryV2Resource{}
+var _ resource.ResourceWithImportState = &TorqueSpaceRepositoryV2Resource{}
+var _ resource.ResourceWithValidateConfig = &TorqueSpaceRepositoryV2Resource{}
+var _ resource.ResourceWithMoveState = &TorqueSpaceRepositoryV2Resource{}
+
+// Repository types that are accessed through the agents associated with the space.
+var agentRepositoryTypes = []string{"gitlab_enterprise", "ado_server", "github_enterprise", "bitbucket_server"}
+
+// Repository types hosted by a cloud git provider.
+var cloudRepositoryTypes = []string{"github", "bitbucket", "gitlab", "azure"}
+
+func NewTorqueSpaceRepositoryV2Resource() resource.Resource {
+	return &TorqueSpaceRepositoryV2Resource{}
+}
+
+// TorqueSpaceRepositoryV2Resource defines the resource implementation.
+type TorqueSpaceRepositoryV2Resource struct {
+	client *client.Client
+}
+
+// TorqueSpaceRepositoryV2ResourceModel describes the resource data model.
+type TorqueSpaceRepositoryV2ResourceModel struct {
+	SpaceName            types.String               `tfsdk:"space_name"`
+	RepositoryName       types.String               `tfsdk:"repository_name"`
+	RepositoryUrl        types.String               `tfsdk:"repository_url"`
+	Type                 types.String               `tfsdk:"type"`
+	Branch               types.String               `tfsdk:"branch"`
+	CredentialName       types.String               `tfsdk:"credential_name"`
+	AutoRegisterEac      types.Bool                 `tfsdk:"auto_register_eac"`
+	TimeOut              types.Int32                `tfsdk:"timeout"`
+	Agents               *repositoryAgentsModel     `tfsdk:"agents"`
+	CodeCommit           *repositoryCodeCommitModel `tfsdk:"codecommit"`
+	Connection           *repositoryConnectionModel `tfsdk:"git_connection"`
+	Status               types.String               `tfsdk:"status"`
+	Errors               types.List                 `tfsdk:"errors"`
+	LastCommit           types.String               `tfsdk:"last_synced_commit"`
+	ResyncTrigger        types.String               `tfsdk:"resync_trigger"`
+	DiscoveryFolders     types.List                 `tfsdk:"discovery_folders"`
+	DiscoveredBlueprints types.Int64                `tfsdk:"discovered_blueprints"`
+}
+
+type repositoryAgentsModel struct {
+	Names types.List `tfsdk:"names"`
+}
+
+type repositoryCodeCommitModel struct {
+	RoleArn    types.String `tfsdk:"role_arn"`
+	AwsRegion  types.String `tfsdk:"aws_region"`
+	ExternalId types.String `tfsdk:"external_id"`
+	Username   types.String `tfsdk:"git_username"`
+	Password   types.String `tfsdk:"git_password"`
+}
+
+type repositoryConnectionModel struct {
+	InstallationId types.String `tfsdk:"github_app_installation_id"`
+	OAuthName      types.String `tfsdk:"oauth_connection_name"`
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_space_repository"
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Onboard a repository of any supported type into an existing space. Replaces the per-vendor repository space association resources, which can be migrated to this resource with a ` + "`moved`" + ` block without recreating the repository.
+
+		Repositories onboarded with the deprecated ` + "`access_token`" + ` attribute of ` + "`torque_repository_space_association`" + ` can not be moved, since this resource only authenticates with existing credentials or a git connection. The ` + "`token`" + ` of ` + "`torque_gitlab_enterprise_repository_space_association`" + ` and ` + "`torque_ado_server_repository_space_association`" + ` is not moved, the repository keeps using the credentials named in ` + "`credential_name`" + `, which Torque created from the token.
+
+		Can be imported using ` + "`<space_name>/<repository_name>`" + `.`,
+
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Existing Torque Space name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				Description: "The name of the repository to onboard",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_url": schema.StringAttribute{
+				Description: "Repository URL. For example: https://github.com/<org>/<repo>",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Repository type. Available types: github, bitbucket, gitlab, azure (for Azure DevOps), codecommit, gitlab_enterprise, ado_server, github_enterprise and bitbucket_server.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(slices.Sorted(maps.Keys(client.RepositoryOnboardingPaths))...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Description: "Repository branch to use for blueprints and automation assets",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credential_name": schema.StringAttribute{
+				Description: "The name of existing credentials to use. Required unless git_connection is used.",
+				Optional:    true,
+			},
+			"auto_register_eac": schema.BoolAttribute{
+				Description: "Auto register environment files",
+				Default:     booldefault.StaticBool(false),
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeout": schema.Int32Attribute{
+				Description: "Time in minutes to wait for Torque to sync the repository during the onboarding. Default is 1 minute.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(1),
+			},
+			"agents": schema.SingleNestedAttribute{
+				Description: "Specific agents to use to onboard and sync the repository. Only for gitlab_enterprise, ado_server, github_enterprise and bitbucket_server repositories. If omitted, all agents associated with the space can be used.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"names": schema.ListAttribute{
+						Description: "Names of the agents",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"codecommit": schema.SingleNestedAttribute{
+				Description: "AWS access to the repository. Required for codecommit repositories.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"role_arn": schema.StringAttribute{
+						Description: "AWS Role ARN for Torque to use which has permissions to connect to CodeCommit",
+						Required:    true,
+					},
+					"aws_region": schema.StringAttribute{
+						Description: "AWS Region that hosts the CodeCommit Repository, i.e eu-west-1",
+						Required:    true,
+					},
+					"external_id": schema.StringAttribute{
+						Description: "External ID used in the IAM role trust policy.",
+						Required:    true,
+					},
+					"git_username": schema.StringAttribute{
+						Description: "Git Username",
+						Required:    true,
+					},
+					"git_password": schema.StringAttribute{
+						Description: "Git Password",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"git_connection": schema.SingleNestedAttribute{
+				Description: "Onboard the repository without credentials, using a GitHub App installation or an OAuth connection. Only for github, bitbucket, gitlab and azure repositories.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"github_app_installation_id": schema.StringAttribute{
+						Description: "Installation id of the Torque GitHub App in the GitHub organization of the repository. Only for github repositories.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("oauth_connection_name")),
+						},
+					},
+					"oauth_connection_name": schema.StringAttribute{
+						Description: "The name of an OAuth connection already authorized in the Torque account",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, repoSyncAttributes())
+	maps.Copy(resp.Schema.Attributes, repoDiscoveryAttributes())
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TorqueSpaceRepositoryV2ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	repo_type := data.Type.ValueString()
+	if data.Agents != nil && !slices.Contains(agentRepositoryTypes, repo_type) {
+		resp.Diagnostics.AddAttributeError(path.Root("agents"), "Invalid Attribute Combination",
+			fmt.Sprintf("agents can not be used with %s repositories.", repo_type))
+	}
+	if data.CodeCommit != nil && repo_type != "codecommit" {
+		resp.Diagnostics.AddAttributeError(path.Root("codecommit"), "Invalid Attribute Combination",
+			fmt.Sprintf("codecommit can not be used with %s repositories.", repo_type))
+	}
+	if data.CodeCommit == nil && repo_type == "codecommit" {
+		resp.Diagnostics.AddAttributeError(path.Root("codecommit"), "Missing Attribute Configuration",
+			"codecommit must be set for codecommit repositories.")
+	}
+	if data.Connection != nil {
+		if !slices.Contains(cloudRepositoryTypes, repo_type) {
+			resp.Diagnostics.AddAttributeError(path.Root("git_connection"), "Invalid Attribute Combination",
+				fmt.Sprintf("git_connection can not be used with %s repositories.", repo_type))
+		} else if !data.Connection.InstallationId.IsNull() && repo_type != "github" {
+			resp.Diagnostics.AddAttributeError(path.Root("git_connection").AtName("github_app_installation_id"), "Invalid Attribute Combination",
+				"github_app_installation_id can only be used with github repositories.")
+		}
+		if !data.CredentialName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("credential_name"), "Invalid Attribute Combination",
+				"credential_name can not be used together with git_connection.")
+		}
+	} else if data.CredentialName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("credential_name"), "Missing Attribute Configuration",
+			"credential_name must be set unless git_connection is used.")
+	}
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueSpaceRepositoryV2ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := client.RepositoryOnboarding{
+		Name:            data.RepositoryName.ValueString(),
+		URL:             data.RepositoryUrl.ValueString(),
+		Type:            data.Type.ValueString(),
+		Branch:          data.Branch.ValueString(),
+		CredentialName:  data.CredentialName.ValueString(),
+		AutoRegisterEac: data.AutoRegisterEac.ValueBool(),
+	}
+	if slices.Contains(agentRepositoryTypes, data.Type.ValueString()) {
+		agents, diags := repositoryAgents(ctx, data.Agents)
+		resp.Diagnostics.Append(diags...)
+		use_all_agents := len(agents) == 0
+		repository.Agents = agents
+		repository.UseAllAgents = &use_all_agents
+	}
+	if data.CodeCommit != nil {
+		repository.RoleArn = data.CodeCommit.RoleArn.ValueString()
+		repository.Region = data.CodeCommit.AwsRegion.ValueString()
+		repository.ExternalId = data.CodeCommit.ExternalId.ValueString()
+		repository.Username = data.CodeCommit.Username.ValueString()
+		repository.Password = data.CodeCommit.Password.ValueString()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if data.Connection != nil {
+		err = r.client.OnboardRepoToSpaceWithConnection(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.Type.ValueString(), data.RepositoryUrl.ValueString(),
+			data.Branch.ValueString(), data.Connection.InstallationId.ValueStringPointer(), data.Connection.OAuthName.ValueStringPointer(), data.AutoRegisterEac.ValueBool())
+	} else {
+		err = r.client.OnboardRepositoryToSpace(data.SpaceName.ValueString(), repository)
+	}
+	if err != nil {
+		// Onboarding fails while the repository is still syncing, in that case wait for the sync to complete.
+		repo, _ := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+		if repo == nil || repo.Status != "Syncing" {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to onboard repository to space, got error: %s", err))
+			return
+		}
+	}
+	// Save the onboarded repository right away, so it stays tracked by Terraform if a later step fails.
+	clearRepoStatus(&data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DiscoveryFolders.IsNull() {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	timeout := time.Duration(data.TimeOut.ValueInt32()) * time.Minute
+	repo, err := waitForRepoSync(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Sync Timeout", fmt.Sprintf("Error while syncing repository: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	data.DiscoveredBlueprints = types.Int64Value(repo.BlueprintsCount)
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueSpaceRepositoryV2ResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.client.GetRepoDetails(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		// The repository was removed from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+	data.RepositoryUrl = types.StringValue(repo.URL)
+	if data.Type.IsNull() && repo.Type != "" {
+		data.Type = types.StringValue(repo.Type)
+	}
+	if !data.Branch.IsNull() || repo.Branch != "" {
+		data.Branch = types.StringValue(repo.Branch)
+	}
+	if !data.CredentialName.IsNull() || repo.CredentialName != "" {
+		data.CredentialName = types.StringValue(repo.CredentialName)
+	}
+	if slices.Contains(agentRepositoryTypes, data.Type.ValueString()) {
+		if repo.UseAllAgents {
+			data.Agents = nil
+		} else {
+			agents := []string{}
+			for _, agent := range repo.Agents {
+				agents = append(agents, agent.Name)
+			}
+			names, diags := types.ListValueFrom(ctx, types.StringType, agents)
+			resp.Diagnostics.Append(diags...)
+			data.Agents = &repositoryAgentsModel{Names: names}
+		}
+	}
+	// Imported repositories have no timeout in the state yet.
+	if data.TimeOut.IsNull() {
+		data.TimeOut = types.Int32Value(1)
+	}
+	resp.Diagnostics.Append(setRepoSyncStatus(ctx, repo, &data.Status, &data.Errors, &data.LastCommit)...)
+	resp.Diagnostics.Append(setRepoDiscovery(ctx, repo, &data.AutoRegisterEac, &data.DiscoveryFolders, &data.DiscoveredBlueprints)...)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TorqueSpaceRepositoryV2ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.Contains(agentRepositoryTypes, data.Type.ValueString()) {
+		agents, diags := repositoryAgents(ctx, data.Agents)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.client.UpdateRepoConfiguration(data.SpaceName.ValueString(), data.RepositoryName.ValueString(),
+			data.CredentialName.ValueString(), agents, len(agents) == 0)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository configuration, got error: %s", err))
+			return
+		}
+	} else if !data.CredentialName.Equal(state.CredentialName) {
+		err := r.client.UpdateRepoCredentials(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.CredentialName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change repository credentials, got error: %s", err))
+			return
+		}
+	}
+	if !data.AutoRegisterEac.Equal(state.AutoRegisterEac) || !data.DiscoveryFolders.Equal(state.DiscoveryFolders) {
+		resp.Diagnostics.Append(updateRepoDiscovery(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.AutoRegisterEac, data.DiscoveryFolders)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resyncRepo(r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.ResyncTrigger, state.ResyncTrigger)...)
+	resp.Diagnostics.Append(readRepoSyncStatus(ctx, r.client, data.SpaceName.ValueString(), data.RepositoryName.ValueString(), &data.Status, &data.Errors, &data.LastCommit, &data.DiscoveredBlueprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueSpaceRepositoryV2ResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove repo from space.
+	err := r.client.RemoveRepoFromSpace(data.SpaceName.ValueString(), data.RepositoryName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove repository from space, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRepoState(ctx, req, resp)
+}
+
+func (r *TorqueSpaceRepositoryV2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		repositoryStateMover(ctx, NewTorqueSpaceRepositoryResource(), func(ctx context.Context, source *tfsdk.State, target *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics {
+			var legacy TorqueSpaceRepositoryResourceModel
+			diags := source.Get(ctx, &legacy)
+			if !legacy.RepoToken.IsNull() && legacy.CredentialName.IsNull() {
+				diags.AddError("Unable to Move Resource State",
+					fmt.Sprintf("Repository %s was onboarded with an access_token, which torque_space_repository does not support. "+
+						"Store the token with the torque_git_credentials resource and onboard the repository again with torque_space_repository using credential_name.", legacy.RepoName.ValueString()))
+				return diags
+			}
+			target.SpaceName = legacy.SpaceName
+			target.RepositoryName = legacy.RepoName
+			target.RepositoryUrl = legacy.RepoUrl
+			target.Type = legacy.RepoType
+			target.Branch = legacy.RepoBranch
+			target.CredentialName = legacy.CredentialName
+			if !legacy.InstallationId.IsNull() || !legacy.OAuthName.IsNull() {
+				target.Connection = &repositoryConnectionModel{InstallationId: legacy.InstallationId, OAuthName: legacy.OAuthName}
+			}
+			target.TimeOut = types.Int32Value(1)
+			target.AutoRegisterEac = legacy.AutoRegisterEac
+			target.Status, target.Errors, target.LastCommit, target.ResyncTrigger = legacy.Status, legacy.Errors, legacy.LastCommit, legacy.ResyncTrigger
+			target.DiscoveryFolders, target.DiscoveredBlueprints = legacy.DiscoveryFolders, legacy.DiscoveredBlueprints
+			return diags
+		}),
+		repositoryStateMover(ctx, NewTorqueSpaceCodeCommitRepositoryResource(), func(ctx context.Context, source *tfsdk.State, target *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics {
+			var legacy TorqueSpaceCodeCommitRepositoryResourceModel
+			diags := source.Get(ctx, &legacy)
+			target.SpaceName = legacy.SpaceName
+			target.RepositoryName = legacy.RepositoryName
+			target.RepositoryUrl = legacy.RepositoryUrl
+			target.Type = types.StringValue("codecommit")
+			target.Branch = legacy.Branch
+			target.CredentialName = legacy.CredentialName
+			target.CodeCommit = &repositoryCodeCommitModel{
+				RoleArn:    legacy.RoleArn,
+				AwsRegion:  legacy.AwsRegion,
+				ExternalId: legacy.ExternalId,
+				Username:   legacy.Username,
+				Password:   legacy.Password,
+			}
+			target.TimeOut = types.Int32Value(1)
+			target.AutoRegisterEac = legacy.AutoRegisterEac
+			target.Status, target.Errors, target.LastCommit, target.ResyncTrigger = legacy.Status, legacy.Errors, legacy.LastCommit, legacy.ResyncTrigger
+			target.DiscoveryFolders, target.DiscoveredBlueprints = legacy.DiscoveryFolders, legacy.DiscoveredBlueprints
+			return diags
+		}),
+		repositoryStateMover(ctx, NewTorqueSpaceGitlabEnterpriseRepositoryResource(), func(ctx context.Context, source *tfsdk.State, target *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics {
+			var legacy TorqueSpaceGitlabEnterpriseRepositoryResourceModel
+			diags := source.Get(ctx, &legacy)
+			moveAgentRepository(target, "gitlab_enterprise", legacy.SpaceName, legacy.RepositoryName, legacy.RepositoryUrl, legacy.Branch, legacy.CredentialName, legacy.UseAllAgents, legacy.Agents, legacy.TimeOut, legacy.AutoRegisterEac)
+			target.Status, target.Errors, target.LastCommit, target.ResyncTrigger = legacy.Status, legacy.Errors, legacy.LastCommit, legacy.ResyncTrigger
+			target.DiscoveryFolders, target.DiscoveredBlueprints = legacy.DiscoveryFolders, legacy.DiscoveredBlueprints
+			return diags
+		}),
+		repositoryStateMover(ctx, NewTorqueSpaceAdoServerRepositoryResource(), func(ctx context.Context, source *tfsdk.State, target *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics {
+			var legacy TorqueSpaceAdoServerRepositoryResourceModel
+			diags := source.Get(ctx, &legacy)
+			moveAgentRepository(target, "ado_server", legacy.SpaceName, legacy.RepositoryName, legacy.RepositoryUrl, legacy.Branch, legacy.CredentialName, legacy.UseAllAgents, legacy.Agents, legacy.TimeOut, legacy.AutoRegisterEac)
+			target.Status, target.Errors, target.LastCommit, target.ResyncTrigger = legacy.Status, legacy.Errors, legacy.LastCommit, legacy.ResyncTrigger
+			target.DiscoveryFolders, target.DiscoveredBlueprints = legacy.DiscoveryFolders, legacy.DiscoveredBlueprints
+			return diags
+		}),
+		agentRepositoryStateMover(ctx, NewTorqueSpaceGithubEnterpriseRepositoryResource()),
+		agentRepositoryStateMover(ctx, NewTorqueSpaceBitbucketServerRepositoryResource()),
+	}
+}
+
+// repositoryAgents returns the names of the specific agents of the repository, none means all agents can be used.
+func repositoryAgents(ctx context.Context, agents *repositoryAgentsModel) ([]string, diag.Diagnostics) {
+	names := []string{}
+	if agents == nil {
+		return names, nil
+	}
+	diags := agents.Names.ElementsAs(ctx, &names, false)
+	return names, diags
+}
+
+// repositoryStateMover moves the state of a legacy repository space association resource to this resource.
+func repositoryStateMover(ctx context.Context, source resource.Resource, move func(context.Context, *tfsdk.State, *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics) resource.StateMover {
+	metadata := resource.MetadataResponse{}
+	source.Metadata(ctx, resource.MetadataRequest{}, &metadata)
+	sourceSchema := resource.SchemaResponse{}
+	source.Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != metadata.TypeName || !strings.HasSuffix(req.SourceProviderAddress, "qualitorque/torque") {
+				return
+			}
+			if req.SourceState == nil {
+				resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("The state of %s could not be read.", req.SourceTypeName))
+				return
+			}
+			var data TorqueSpaceRepositoryV2ResourceModel
+			resp.Diagnostics.Append(move(ctx, req.SourceState, &data)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		},
+	}
+}
+
+// agentRepositoryStateMover moves the state of a repository space association resource shared by the agent
+// repository types to this resource.
+func agentRepositoryStateMover(ctx context.Context, source resource.Resource) resource.StateMover {
+	repo_type := source.(*TorqueSpaceAgentRepositoryResource).repoType
+	return repositoryStateMover(ctx, source, func(ctx context.Context, source *tfsdk.State, target *TorqueSpaceRepositoryV2ResourceModel) diag.Diagnostics {
+		var legacy TorqueSpaceAgentRepositoryResourceModel
+		diags := source.Get(ctx, &legacy)
+		moveAgentRepository(target, repo_type, legacy.SpaceName, legacy.RepositoryName, legacy.RepositoryUrl, legacy.Branch, legacy.CredentialName, legacy.UseAllAgents, legacy.Agents, legacy.TimeOut, legacy.AutoRegisterEac)
+		target.Status, target.Errors, target.LastCommit, target.ResyncTrigger = legacy.Status, legacy.Errors, legacy.LastCommit, legacy.ResyncTrigger
+		target.DiscoveryFolders, target.DiscoveredBlueprints = legacy.DiscoveryFolders, legacy.DiscoveredBlueprints
+		return diags
+	})
+}
+
+// moveAgentRepository sets the state of a repository accessed through agents from its legacy resource state.
+func moveAgentRepository(target *TorqueSpaceRepositoryV2ResourceModel, repo_type string, space_name types.String, repo_name types.String, repo_url types.String,
+	branch types.String, credential_name types.String, use_all_agents types.Bool, agents types.List, timeout types.Int32, auto_register_eac types.Bool) {
+	target.SpaceName = space_name
+	target.RepositoryName = repo_name
+	target.RepositoryUrl = repo_url
+	target.Type = types.StringValue(repo_type)
+	target.Branch = branch
+	target.CredentialName = credential_name
+	if !use_all_agents.ValueBool() && len(agents.Elements()) > 0 {
+		target.Agents = &repositoryAgentsModel{Names: agents}
+	}
+	target.TimeOut = timeout
+	target.AutoRegisterEac = auto_register_eac
+}
@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueAgentResource{}
+var _ resource.ResourceWithImportState = &TorqueAgentResource{}
+
+func NewTorqueAgentResource() resource.Resource {
+	return &TorqueAgentResource{}
+}
+
+// TorqueAgentResource defines the resource implementation.
+type TorqueAgentResource struct {
+	client *client.Client
+}
+
+// TorqueAgentResourceModel describes the resource data model.
+type TorqueAgentResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Description     types.String `tfsdk:"description"`
+	Token           types.String `tfsdk:"token"`
+	InstallManifest types.String `tfsdk:"install_manifest"`
+	Status          types.String `tfsdk:"status"`
+	Version         types.String `tfsdk:"version"`
+}
+
+func (r *TorqueAgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_agent"
+}
+
+func (r *TorqueAgentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Registers a new agent in Torque and returns the registration token and install manifest used to deploy it to a Kubernetes cluster, Docker host or VM. Once installed, the agent can be associated to spaces with the torque_agent_space_association resource.
+
+		The token and install manifest are only returned when the agent is registered, they are not available for imported agents. Destroying this resource deletes the agent from Torque.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the agent.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the agent. One of K8S, Docker or VM. Default is K8S.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("K8S"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("K8S", "Docker", "VM"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the agent.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The registration token the agent uses to connect to Torque.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"install_manifest": schema.StringAttribute{
+				MarkdownDescription: "The Helm/YAML install manifest of the agent, including the registration token.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The connectivity status of the agent.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the installed agent.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TorqueAgentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueAgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueAgentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registration, err := r.client.CreateAgent(data.Name.ValueString(), data.Type.ValueString(), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to register agent, got error: %s", err))
+		return
+	}
+	data.Token = types.StringValue(registration.Token)
+	data.InstallManifest = types.StringValue(registration.InstallManifest)
+	// The token and install manifest are only returned upon registration, so they are saved right away.
+	data.Status = types.StringNull()
+	data.Version = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := r.client.GetAgent(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to read agent status", fmt.Sprintf("The agent was registered, its status and version will be read on the next refresh. Got error: %s", err))
+		return
+	}
+	data.Status = types.StringValue(agent.Status)
+	data.Version = types.StringValue(agent.Version)
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueAgentResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := r.client.GetAgent(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read agent, got error: %s", err))
+		return
+	}
+
+	data.Type = types.StringValue(agent.Type)
+	if !data.Description.IsNull() || agent.Description != "" {
+		data.Description = types.StringValue(agent.Description)
+	}
+	data.Status = types.StringValue(agent.Status)
+	data.Version = types.StringValue(agent.Version)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TorqueAgentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All arguments require replacement, there is nothing to update in Torque.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAgentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueAgentResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAgent(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete agent, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,335 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueApprovalPolicyResource{}
+var _ resource.ResourceWithImportState = &TorqueApprovalPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &TorqueApprovalPolicyResource{}
+
+func NewTorqueApprovalPolicyResource() resource.Resource {
+	return &TorqueApprovalPolicyResource{}
+}
+
+// TorqueApprovalPolicyResource defines the resource implementation.
+type TorqueApprovalPolicyResource struct {
+	client *client.Client
+}
+
+// TorqueApprovalPolicyResourceModel describes the resource data model.
+type TorqueApprovalPolicyResourceModel struct {
+	Name            types.String                  `tfsdk:"name"`
+	Description     types.String                  `tfsdk:"description"`
+	ApprovalChannel types.String                  `tfsdk:"approval_channel"`
+	SpaceName       types.String                  `tfsdk:"space_name"`
+	BlueprintName   types.String                  `tfsdk:"blueprint_name"`
+	RepositoryName  types.String                  `tfsdk:"repository_name"`
+	Conditions      *ApprovalPolicyConditionModel `tfsdk:"conditions"`
+}
+
+type ApprovalPolicyConditionModel struct {
+	DurationAbove types.String                        `tfsdk:"duration_above"`
+	CostAbove     types.Float64                       `tfsdk:"cost_above"`
+	Inputs        []ApprovalPolicyInputConditionModel `tfsdk:"inputs"`
+}
+
+type ApprovalPolicyInputConditionModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
+}
+
+func (r *TorqueApprovalPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_approval_policy"
+}
+
+func (r *TorqueApprovalPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Requires approval from an approval channel to launch environments in a space, or from a specific catalog item of the space. When conditions are set, approval is only required for launches that match at least one of them.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the approval policy.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the approval policy",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"approval_channel": schema.StringAttribute{
+				MarkdownDescription: "Name of an existing approval channel whose approvers approve the launches, for example a torque_email_approval_channel name.",
+				Required:            true,
+			},
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the space the policy applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"blueprint_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the catalog item blueprint the policy applies to. When not set, the policy applies to all catalog items of the space.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("repository_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository of the catalog item blueprint.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("blueprint_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"conditions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Conditions of launches that require approval. When not set, every launch requires approval.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"duration_above": schema.StringAttribute{
+						MarkdownDescription: "Require approval for environments launched for longer than this ISO 8601 duration, for example P1D.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`),
+								"must be a valid ISO 8601 duration (e.g., P1D or PT12H)",
+							),
+						},
+					},
+					"cost_above": schema.Float64Attribute{
+						MarkdownDescription: "Require approval for environments with an estimated cost above this amount.",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"inputs": schema.ListNestedAttribute{
+						MarkdownDescription: "Require approval for environments launched with specific input values.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Name of the blueprint input",
+									Required:            true,
+								},
+								"values": schema.ListAttribute{
+									MarkdownDescription: "Values of the input that require approval",
+									Required:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TorqueApprovalPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TorqueApprovalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Conditions != nil && data.Conditions.DurationAbove.IsNull() && data.Conditions.CostAbove.IsNull() && len(data.Conditions.Inputs) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("conditions"),
+			"Missing Approval Policy Condition",
+			"conditions must set at least one of duration_above, cost_above or inputs. Remove conditions to require approval for every launch.",
+		)
+	}
+}
+
+func (r *TorqueApprovalPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueApprovalPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueApprovalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := approvalPolicy(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.CreateApprovalPolicy(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Approval Policy, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueApprovalPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueApprovalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetApprovalPolicy(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Approval Policy details",
+			"Could not read Approval Policy "+data.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	data.Name = types.StringValue(policy.Name)
+	data.Description = types.StringValue(policy.Description)
+	data.ApprovalChannel = types.StringValue(policy.ApprovalChannel)
+	data.SpaceName = types.StringValue(policy.SpaceName)
+	data.BlueprintName = types.StringPointerValue(policy.BlueprintName)
+	data.RepositoryName = types.StringPointerValue(policy.RepositoryName)
+	data.Conditions = nil
+	if policy.Conditions != nil && (policy.Conditions.DurationAbove != nil || policy.Conditions.CostAbove != nil || len(policy.Conditions.Inputs) > 0) {
+		data.Conditions = &ApprovalPolicyConditionModel{
+			DurationAbove: types.StringPointerValue(policy.Conditions.DurationAbove),
+			CostAbove:     types.Float64PointerValue(policy.Conditions.CostAbove),
+		}
+		for _, input := range policy.Conditions.Inputs {
+			values, diags := types.ListValueFrom(ctx, types.StringType, input.Values)
+			resp.Diagnostics.Append(diags...)
+			data.Conditions.Inputs = append(data.Conditions.Inputs, ApprovalPolicyInputConditionModel{
+				Name:   types.StringValue(input.Name),
+				Values: values,
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueApprovalPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TorqueApprovalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := approvalPolicy(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.UpdateApprovalPolicy(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Approval Policy, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueApprovalPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueApprovalPolicyResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteApprovalPolicy(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Approval Policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueApprovalPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// approvalPolicy converts the resource data model to the Torque API model.
+func approvalPolicy(ctx context.Context, data *TorqueApprovalPolicyResourceModel) (client.ApprovalPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := client.ApprovalPolicy{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		ApprovalChannel: data.ApprovalChannel.ValueString(),
+		SpaceName:       data.SpaceName.ValueString(),
+		BlueprintName:   data.BlueprintName.ValueStringPointer(),
+		RepositoryName:  data.RepositoryName.ValueStringPointer(),
+	}
+	if data.Conditions != nil {
+		policy.Conditions = &client.ApprovalPolicyConditions{
+			DurationAbove: data.Conditions.DurationAbove.ValueStringPointer(),
+			CostAbove:     data.Conditions.CostAbove.ValueFloat64Pointer(),
+		}
+		for _, input := range data.Conditions.Inputs {
+			values := []string{}
+			diags.Append(input.Values.ElementsAs(ctx, &values, false)...)
+			policy.Conditions.Inputs = append(policy.Conditions.Inputs, client.ApprovalPolicyInputCondition{
+				Name:   input.Name.ValueString(),
+				Values: values,
+			})
+		}
+	}
+	return policy, diags
+}
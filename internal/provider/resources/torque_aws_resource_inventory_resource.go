@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -156,6 +157,20 @@ func (r *TorqueAwsResourceInventoryResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	inventory, err := r.client.GetResourceInventory(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource inventory, got error: %s", err))
+		return
+	}
+	if inventory.Details.ViewArn != nil {
+		data.ViewArn = types.StringValue(*inventory.Details.ViewArn)
+	}
+
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
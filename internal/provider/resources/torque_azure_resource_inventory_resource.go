@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueAzureResourceInventoryResource{}
+var _ resource.ResourceWithImportState = &TorqueAzureResourceInventoryResource{}
+
+func NewTorqueAzureResourceInventoryResource() resource.Resource {
+	return &TorqueAzureResourceInventoryResource{}
+}
+
+// TorqueAzureResourceInventoryResource defines the resource implementation.
+type TorqueAzureResourceInventoryResource struct {
+	client *client.Client
+}
+
+// TorqueAzureResourceInventoryResourceModel describes the resource data model.
+type TorqueAzureResourceInventoryResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	TenantId        types.String `tfsdk:"tenant_id"`
+	ClientId        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	SubscriptionIds types.List   `tfsdk:"subscription_ids"`
+	CloudType       types.String `tfsdk:"cloud_type"`
+}
+
+func (r *TorqueAzureResourceInventoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_azure_resource_inventory"
+}
+
+func (r *TorqueAzureResourceInventoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creation of a new Azure cloud resource inventory in Torque account to allow curation of the resources in the Azure subscriptions. Resources are discovered with Azure Resource Graph.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Azure Cloud Account. Will also be used to store the provided credentials in Torque's credential store for later use.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the credentials that will be created.",
+				Required:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Azure tenant id of the service principal.",
+				Required:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client id of a service principal with the Reader role on the subscriptions, used to query Azure Resource Graph.",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the service principal.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"subscription_ids": schema.ListAttribute{
+				MarkdownDescription: "Ids of the Azure subscriptions to discover resources in.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"cloud_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the resource inventory.",
+				Required:            false,
+				Computed:            true,
+				Default:             stringdefault.StaticString("azure"),
+			},
+		},
+	}
+}
+
+func (r *TorqueAzureResourceInventoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueAzureResourceInventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueAzureResourceInventoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.CreateCloudAccountCredentials(r.credentials(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resource inventory credentials, got error: %s", err))
+		return
+	}
+	details := client.ResourceInventoryDetails{
+		Type: data.CloudType.ValueString(),
+	}
+	resp.Diagnostics.Append(data.SubscriptionIds.ElementsAs(ctx, &details.SubscriptionIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err = r.client.ConfigureResourveInventory(data.Name.ValueString(), details)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resource inventory, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureResourceInventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueAzureResourceInventoryResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inventory, err := r.client.GetResourceInventory(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource inventory, got error: %s", err))
+		return
+	}
+	subscription_ids, diags := types.ListValueFrom(ctx, types.StringType, inventory.Details.SubscriptionIds)
+	resp.Diagnostics.Append(diags...)
+	data.SubscriptionIds = subscription_ids
+
+	// The service principal details are kept in the credentials the inventory refers to.
+	credentials, err := r.client.GetCredentials(inventory.Credentials)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource inventory credentials, got error: %s", err))
+		return
+	}
+	data.Description = types.StringValue(credentials.Description)
+	data.TenantId = types.StringValue(credentials.CloudIdentifier)
+	if credentials.CredentialData.ClientId != nil {
+		data.ClientId = types.StringValue(*credentials.CredentialData.ClientId)
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureResourceInventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TorqueAzureResourceInventoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.UpdateCloudAccountCredentials(r.credentials(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update resource inventory credentials, got error: %s", err))
+		return
+	}
+	details := client.ResourceInventoryDetails{
+		Type: data.CloudType.ValueString(),
+	}
+	resp.Diagnostics.Append(data.SubscriptionIds.ElementsAs(ctx, &details.SubscriptionIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err = r.client.ConfigureResourveInventory(data.Name.ValueString(), details)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update resource inventory, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueAzureResourceInventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueAzureResourceInventoryResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteResourceInventory(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource inventory, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueAzureResourceInventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (r *TorqueAzureResourceInventoryResource) credentials(data TorqueAzureResourceInventoryResourceModel) client.AccountCredentials {
+	const credential_type = "azure__service_principal"
+	return client.AccountCredentials{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		CloudType:       data.CloudType.ValueString(),
+		CloudIdentifier: data.TenantId.ValueString(),
+		CredentialData: client.CredentialData{
+			Type:         credential_type,
+			TenantId:     data.TenantId.ValueStringPointer(),
+			ClientId:     data.ClientId.ValueStringPointer(),
+			ClientSecret: data.ClientSecret.ValueStringPointer(),
+		},
+		AllSpacesAllowed: true,
+	}
+}
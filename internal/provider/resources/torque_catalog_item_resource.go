@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -22,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TorqueCatalogItemResource{}
 var _ resource.ResourceWithImportState = &TorqueCatalogItemResource{}
+var _ resource.ResourceWithValidateConfig = &TorqueCatalogItemResource{}
 
 func NewTorqueCatalogItemResource() resource.Resource {
 	return &TorqueCatalogItemResource{}
@@ -34,19 +36,27 @@ type TorqueCatalogItemResource struct {
 
 // TorqueCatalogItemResourceModel describes the resource data model.
 type TorqueCatalogItemResourceModel struct {
-	SpaceName             types.String `tfsdk:"space_name"`
-	BlueprintName         types.String `tfsdk:"blueprint_name"`
-	DisplayName           types.String `tfsdk:"display_name"`
-	SelfService           types.Bool   `tfsdk:"self_service"`
-	RepositoryName        types.String `tfsdk:"repository_name"`
-	MaxDuration           types.String `tfsdk:"max_duration"`
-	DefaultDuration       types.String `tfsdk:"default_duration"`
-	DefaultExtend         types.String `tfsdk:"default_extend"`
-	MaxActiveEnvironments types.Int32  `tfsdk:"max_active_environments"`
-	AlwaysOn              types.Bool   `tfsdk:"always_on"`
-	AllowScheduling       types.Bool   `tfsdk:"allow_scheduling"`
-	CustomIcon            types.String `tfsdk:"custom_icon"`
-	Labels                types.List   `tfsdk:"labels"`
+	SpaceName             types.String            `tfsdk:"space_name"`
+	BlueprintName         types.String            `tfsdk:"blueprint_name"`
+	DisplayName           types.String            `tfsdk:"display_name"`
+	SelfService           types.Bool              `tfsdk:"self_service"`
+	RepositoryName        types.String            `tfsdk:"repository_name"`
+	MaxDuration           types.String            `tfsdk:"max_duration"`
+	DefaultDuration       types.String            `tfsdk:"default_duration"`
+	DefaultExtend         types.String            `tfsdk:"default_extend"`
+	MaxActiveEnvironments types.Int32             `tfsdk:"max_active_environments"`
+	AlwaysOn              types.Bool              `tfsdk:"always_on"`
+	AllowScheduling       types.Bool              `tfsdk:"allow_scheduling"`
+	CustomIcon            types.String            `tfsdk:"custom_icon"`
+	Labels                types.List              `tfsdk:"labels"`
+	Inputs                []CatalogItemInputModel `tfsdk:"inputs"`
+}
+
+type CatalogItemInputModel struct {
+	Name         types.String `tfsdk:"name"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Locked       types.Bool   `tfsdk:"locked"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
 }
 
 func (r *TorqueCatalogItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -183,10 +193,59 @@ func (r *TorqueCatalogItemResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            false,
 				ElementType:         types.StringType,
 			},
+			"inputs": schema.ListNestedAttribute{
+				MarkdownDescription: "Settings of blueprint inputs for users launching the catalog item. Inputs that are not listed keep the blueprint defaults and can be set by users.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the blueprint input",
+							Required:            true,
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "Value that overrides the default value of the input in the blueprint",
+							Optional:            true,
+						},
+						"locked": schema.BoolAttribute{
+							MarkdownDescription: "Prevent users from changing the value of the input when launching the catalog item.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Hide the input from users launching the catalog item, the default value is used.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *TorqueCatalogItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TorqueCatalogItemResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, input := range data.Inputs {
+		if input.DefaultValue.IsNull() && !input.Locked.ValueBool() && !input.Hidden.ValueBool() &&
+			!input.Locked.IsUnknown() && !input.Hidden.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("inputs").AtListIndex(i),
+				"Invalid Input Settings",
+				fmt.Sprintf("Input %s must set at least one of default_value, locked or hidden.", input.Name.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *TorqueCatalogItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -274,6 +333,13 @@ func (r *TorqueCatalogItemResource) Create(ctx context.Context, req resource.Cre
 			return
 		}
 	}
+	if len(data.Inputs) > 0 {
+		err = r.client.SetCatalogItemInputs(data.SpaceName.ValueString(), data.BlueprintName.ValueString(), data.RepositoryName.ValueString(), catalogItemInputs(data.Inputs))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Catalog Item, failed to set inputs, got error: %s", err))
+			return
+		}
+	}
 
 	tflog.Trace(ctx, "Resource Created Successful!")
 
@@ -307,6 +373,7 @@ func (r *TorqueCatalogItemResource) Read(ctx context.Context, req resource.ReadR
 	data.MaxActiveEnvironments = types.Int32PointerValue(blueprint.Policies.MaxActiveEnvironments)
 	data.AlwaysOn = types.BoolValue(blueprint.Policies.AlwaysOn)
 	data.AllowScheduling = types.BoolValue(blueprint.Policies.AllowScheduling)
+	data.Inputs = readCatalogItemInputs(data.Inputs, blueprint.Inputs)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -382,6 +449,11 @@ func (r *TorqueCatalogItemResource) Update(ctx context.Context, req resource.Upd
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Catalog Item, failed to update labels, got error: %s", err))
 		return
 	}
+	err = r.client.SetCatalogItemInputs(data.SpaceName.ValueString(), data.BlueprintName.ValueString(), data.RepositoryName.ValueString(), catalogItemInputs(data.Inputs))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Catalog Item, failed to update inputs, got error: %s", err))
+		return
+	}
 	if data.SelfService.ValueBool() && !state.SelfService.ValueBool() {
 		err = r.client.PublishBlueprintInSpace(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.BlueprintName.ValueString())
 		if err != nil {
@@ -445,8 +517,65 @@ func (r *TorqueCatalogItemResource) Delete(ctx context.Context, req resource.Del
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove Catalog Item labels, got error: %s", err))
 		return
 	}
+	if len(data.Inputs) > 0 {
+		err = r.client.SetCatalogItemInputs(data.SpaceName.ValueString(), data.BlueprintName.ValueString(), data.RepositoryName.ValueString(), []client.CatalogItemInput{})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset Catalog Item inputs, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *TorqueCatalogItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// catalogItemInputs converts the configured input settings to the Torque API model.
+func catalogItemInputs(inputs []CatalogItemInputModel) []client.CatalogItemInput {
+	catalogInputs := []client.CatalogItemInput{}
+	for _, input := range inputs {
+		catalogInputs = append(catalogInputs, client.CatalogItemInput{
+			Name:            input.Name.ValueString(),
+			DefaultOverride: input.DefaultValue.ValueStringPointer(),
+			Locked:          input.Locked.ValueBool(),
+			Hidden:          input.Hidden.ValueBool(),
+		})
+	}
+	return catalogInputs
+}
+
+// readCatalogItemInputs returns the settings of the blueprint inputs customized for the catalog item,
+// keeping the order of the inputs already in the state.
+func readCatalogItemInputs(current []CatalogItemInputModel, blueprintInputs []client.Input) []CatalogItemInputModel {
+	customized := map[string]client.Input{}
+	for _, input := range blueprintInputs {
+		if input.DefaultOverride != nil || input.Locked || input.Hidden {
+			customized[input.Name] = input
+		}
+	}
+	names := []string{}
+	for _, input := range current {
+		if _, ok := customized[input.Name.ValueString()]; ok {
+			names = append(names, input.Name.ValueString())
+		}
+	}
+	for _, input := range blueprintInputs {
+		if _, ok := customized[input.Name]; ok && !slices.Contains(names, input.Name) {
+			names = append(names, input.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	inputs := []CatalogItemInputModel{}
+	for _, name := range names {
+		input := customized[name]
+		inputs = append(inputs, CatalogItemInputModel{
+			Name:         types.StringValue(input.Name),
+			DefaultValue: types.StringPointerValue(input.DefaultOverride),
+			Locked:       types.BoolValue(input.Locked),
+			Hidden:       types.BoolValue(input.Hidden),
+		})
+	}
+	return inputs
+}
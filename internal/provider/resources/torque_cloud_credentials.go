@@ -0,0 +1,466 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueCloudCredentialsResource{}
+var _ resource.ResourceWithImportState = &TorqueCloudCredentialsResource{}
+
+const (
+	cloudCredentialsTypeAws        = "aws"
+	cloudCredentialsTypeAzure      = "azure"
+	cloudCredentialsTypeGcp        = "gcp"
+	cloudCredentialsTypeKubernetes = "kubernetes"
+)
+
+func NewTorqueCloudCredentialsResource() resource.Resource {
+	return &TorqueCloudCredentialsResource{}
+}
+
+// TorqueCloudCredentialsResource defines the resource implementation.
+type TorqueCloudCredentialsResource struct {
+	client *client.Client
+}
+
+// TorqueCloudCredentialsResourceModel describes the resource data model.
+type TorqueCloudCredentialsResourceModel struct {
+	Name              types.String                `tfsdk:"name"`
+	Description       types.String                `tfsdk:"description"`
+	AllowedSpaceNames types.List                  `tfsdk:"allowed_space_names"`
+	SecretsVersion    types.Int64                 `tfsdk:"secrets_version"`
+	Aws               *awsCloudCredentialsModel   `tfsdk:"aws"`
+	Azure             *azureCloudCredentialsModel `tfsdk:"azure"`
+	Gcp               *gcpCloudCredentialsModel   `tfsdk:"gcp"`
+	Kubernetes        *kubernetesCredentialsModel `tfsdk:"kubernetes"`
+}
+
+type awsCloudCredentialsModel struct {
+	AccountNumber types.String `tfsdk:"account_number"`
+	RoleArn       types.String `tfsdk:"role_arn"`
+	ExternalId    types.String `tfsdk:"external_id"`
+	AccessKey     types.String `tfsdk:"access_key"`
+	SecretKey     types.String `tfsdk:"secret_key"`
+}
+
+type azureCloudCredentialsModel struct {
+	TenantId     types.String `tfsdk:"tenant_id"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+type gcpCloudCredentialsModel struct {
+	ProjectId         types.String `tfsdk:"project_id"`
+	ServiceAccountKey types.String `tfsdk:"service_account_key"`
+}
+
+type kubernetesCredentialsModel struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Kubeconfig  types.String `tfsdk:"kubeconfig"`
+}
+
+func (r *TorqueCloudCredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_cloud_credentials"
+}
+
+func (r *TorqueCloudCredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	cloudBlocks := path.Expressions{
+		path.MatchRoot("aws"),
+		path.MatchRoot("azure"),
+		path.MatchRoot("gcp"),
+		path.MatchRoot("kubernetes"),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creation of new cloud account credentials in Torque's credential store, which can later be used by environments, agents and resource inventories. Exactly one of aws, azure, gcp or kubernetes must be set.
+
+		Secrets are write-only and are never stored in the Terraform state, which requires Terraform 1.11 or later. Since changes to write-only values cannot be detected, increment secrets_version to update the secrets in Torque.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the credentials.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the credentials.",
+				Optional:            true,
+			},
+			"allowed_space_names": schema.ListAttribute{
+				MarkdownDescription: "List of space names that are allowed to use these credentials. If omitted, the credentials can be used in all spaces.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"secrets_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only secrets. Change this value to update the secrets in Torque.",
+				Optional:            true,
+			},
+			"aws": schema.SingleNestedAttribute{
+				MarkdownDescription: "AWS credentials, using either an IAM role or the access keys of an IAM user.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(cloudBlocks...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(cloudTypeChanging, "Changing the cloud type of the credentials forces replacement", "Changing the cloud type of the credentials forces replacement"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"account_number": schema.StringAttribute{
+						MarkdownDescription: "AWS account number.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "ARN of the IAM role Torque will assume.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("access_key")),
+						},
+					},
+					"external_id": schema.StringAttribute{
+						MarkdownDescription: "External id of the IAM role trust policy.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("role_arn")),
+						},
+					},
+					"access_key": schema.StringAttribute{
+						MarkdownDescription: "Access key id of the IAM user.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+						},
+					},
+					"secret_key": schema.StringAttribute{
+						MarkdownDescription: "Secret access key of the IAM user. Write-only.",
+						Optional:            true,
+						WriteOnly:           true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				MarkdownDescription: "Azure service principal credentials.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(cloudBlocks...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(cloudTypeChanging, "Changing the cloud type of the credentials forces replacement", "Changing the cloud type of the credentials forces replacement"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "Azure tenant id of the service principal.",
+						Required:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client id of the service principal.",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the service principal. Write-only.",
+						Required:            true,
+						WriteOnly:           true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				MarkdownDescription: "GCP service account credentials.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(cloudBlocks...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(cloudTypeChanging, "Changing the cloud type of the credentials forces replacement", "Changing the cloud type of the credentials forces replacement"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						MarkdownDescription: "Id of the GCP project.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"service_account_key": schema.StringAttribute{
+						MarkdownDescription: "JSON key of the service account. Write-only.",
+						Required:            true,
+						WriteOnly:           true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"kubernetes": schema.SingleNestedAttribute{
+				MarkdownDescription: "Kubernetes cluster credentials.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(cloudBlocks...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(cloudTypeChanging, "Changing the cloud type of the credentials forces replacement", "Changing the cloud type of the credentials forces replacement"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"cluster_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the Kubernetes cluster.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"kubeconfig": schema.StringAttribute{
+						MarkdownDescription: "Kubeconfig used to access the cluster. Write-only.",
+						Required:            true,
+						WriteOnly:           true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TorqueCloudCredentialsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueCloudCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config TorqueCloudCredentialsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only secrets are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, diags := r.credentials(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.CreateCloudAccountCredentials(credentials)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cloud credentials, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueCloudCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueCloudCredentialsResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := r.client.GetCredentials(data.Name.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud credentials, got error: %s", err))
+		return
+	}
+
+	if !data.Description.IsNull() || credentials.Description != "" {
+		data.Description = types.StringValue(credentials.Description)
+	}
+	if credentials.AllSpacesAllowed {
+		data.AllowedSpaceNames = types.ListNull(types.StringType)
+	} else {
+		var diags diag.Diagnostics
+		data.AllowedSpaceNames, diags = types.ListValueFrom(ctx, types.StringType, credentials.AllowedSpaceNames)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	credential_data := credentials.CredentialData
+	switch credentials.CloudType {
+	case cloudCredentialsTypeAws:
+		if data.Aws == nil {
+			data.Aws = &awsCloudCredentialsModel{}
+		}
+		data.Aws.AccountNumber = types.StringValue(credentials.CloudIdentifier)
+		data.Aws.RoleArn = types.StringPointerValue(credential_data.RoleArn)
+		data.Aws.ExternalId = types.StringPointerValue(credential_data.ExternalId)
+		if credential_data.Key != nil {
+			data.Aws.AccessKey = types.StringValue(*credential_data.Key)
+		}
+		data.Aws.SecretKey = types.StringNull()
+	case cloudCredentialsTypeAzure:
+		if data.Azure == nil {
+			data.Azure = &azureCloudCredentialsModel{}
+		}
+		data.Azure.TenantId = types.StringValue(credentials.CloudIdentifier)
+		if credential_data.ClientId != nil {
+			data.Azure.ClientId = types.StringValue(*credential_data.ClientId)
+		}
+		data.Azure.ClientSecret = types.StringNull()
+	case cloudCredentialsTypeGcp:
+		if data.Gcp == nil {
+			data.Gcp = &gcpCloudCredentialsModel{}
+		}
+		data.Gcp.ProjectId = types.StringValue(credentials.CloudIdentifier)
+		data.Gcp.ServiceAccountKey = types.StringNull()
+	case cloudCredentialsTypeKubernetes:
+		if data.Kubernetes == nil {
+			data.Kubernetes = &kubernetesCredentialsModel{}
+		}
+		data.Kubernetes.ClusterName = types.StringValue(credentials.CloudIdentifier)
+		data.Kubernetes.Kubeconfig = types.StringNull()
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueCloudCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, config TorqueCloudCredentialsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, diags := r.credentials(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.UpdateCloudAccountCredentials(credentials)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud credentials, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueCloudCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueCloudCredentialsResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAccountCredentials(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cloud credentials, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueCloudCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// objectplanmodifier function to help determine if a cloud block is added or removed, which changes the cloud type of the credentials.
+func cloudTypeChanging(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+// credentials builds the credential store request from the configuration, which holds the write-only secrets.
+func (r *TorqueCloudCredentialsResource) credentials(ctx context.Context, config TorqueCloudCredentialsResourceModel) (client.AccountCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	allowed_space_names := []string{}
+	if !config.AllowedSpaceNames.IsNull() {
+		diags.Append(config.AllowedSpaceNames.ElementsAs(ctx, &allowed_space_names, false)...)
+	}
+	credentials := client.AccountCredentials{
+		Name:              config.Name.ValueString(),
+		Description:       config.Description.ValueString(),
+		AllowedSpaceNames: allowed_space_names,
+		AllSpacesAllowed:  len(allowed_space_names) == 0,
+	}
+	switch {
+	case config.Aws != nil:
+		credentials.CloudType = cloudCredentialsTypeAws
+		credentials.CloudIdentifier = config.Aws.AccountNumber.ValueString()
+		if !config.Aws.RoleArn.IsNull() {
+			credentials.CredentialData = client.CredentialData{
+				Type:       "aws__role",
+				RoleArn:    config.Aws.RoleArn.ValueStringPointer(),
+				ExternalId: config.Aws.ExternalId.ValueStringPointer(),
+			}
+		} else {
+			credentials.CredentialData = client.CredentialData{
+				Type:   "aws__basic",
+				Key:    config.Aws.AccessKey.ValueStringPointer(),
+				Secret: config.Aws.SecretKey.ValueStringPointer(),
+			}
+		}
+	case config.Azure != nil:
+		credentials.CloudType = cloudCredentialsTypeAzure
+		credentials.CloudIdentifier = config.Azure.TenantId.ValueString()
+		credentials.CredentialData = client.CredentialData{
+			Type:         "azure__service_principal",
+			TenantId:     config.Azure.TenantId.ValueStringPointer(),
+			ClientId:     config.Azure.ClientId.ValueStringPointer(),
+			ClientSecret: config.Azure.ClientSecret.ValueStringPointer(),
+		}
+	case config.Gcp != nil:
+		credentials.CloudType = cloudCredentialsTypeGcp
+		credentials.CloudIdentifier = config.Gcp.ProjectId.ValueString()
+		credentials.CredentialData = client.CredentialData{
+			Type:       "gcp__service_account",
+			ServiceKey: config.Gcp.ServiceAccountKey.ValueStringPointer(),
+		}
+	case config.Kubernetes != nil:
+		credentials.CloudType = cloudCredentialsTypeKubernetes
+		credentials.CloudIdentifier = config.Kubernetes.ClusterName.ValueString()
+		credentials.CredentialData = client.CredentialData{
+			Type:       "k8s__kubeconfig",
+			Kubeconfig: config.Kubernetes.Kubeconfig.ValueStringPointer(),
+		}
+	}
+	return credentials, diags
+}
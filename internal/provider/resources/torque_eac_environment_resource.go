@@ -0,0 +1,231 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueEacEnvironmentResource{}
+var _ resource.ResourceWithImportState = &TorqueEacEnvironmentResource{}
+
+func NewTorqueEacEnvironmentResource() resource.Resource {
+	return &TorqueEacEnvironmentResource{}
+}
+
+// TorqueEacEnvironmentResource defines the resource implementation.
+type TorqueEacEnvironmentResource struct {
+	client *client.Client
+}
+
+// TorqueEacEnvironmentResourceModel describes the resource data model.
+type TorqueEacEnvironmentResourceModel struct {
+	SpaceName      types.String `tfsdk:"space_name"`
+	RepositoryName types.String `tfsdk:"repository_name"`
+	Path           types.String `tfsdk:"path"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	Status         types.String `tfsdk:"status"`
+	Errors         types.List   `tfsdk:"errors"`
+}
+
+func (r *TorqueEacEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_eac_environment"
+}
+
+func (r *TorqueEacEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Registers an Environment-as-Code (EaC) file of a repository onboarded to a space. Torque launches the environment defined by the file and keeps it in sync with the file in git.
+
+		Destroying this resource deregisters the file from the space.`,
+
+		Attributes: map[string]schema.Attribute{
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "Existing Torque Space name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository onboarded to the space that contains the EaC file",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the EaC YAML file in the repository, for example environments/staging.yaml",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment Torque synced from the EaC file. Empty until the file is synced.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Sync status of the EaC file",
+				Computed:            true,
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "Errors reported by Torque while syncing the EaC file",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TorqueEacEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueEacEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueEacEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RegisterEacFile(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to register EaC file, got error: %s", err))
+		return
+	}
+
+	// Save the registered file right away, so it stays tracked by Terraform if reading its status fails.
+	data.EnvironmentId = types.StringNull()
+	data.Status = types.StringNull()
+	data.Errors = types.ListNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.GetEacFile(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to read EaC file status", fmt.Sprintf("The EaC file was registered, its status will be read on the next refresh. Got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setEacFileStatus(ctx, file, &data)...)
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEacEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueEacEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.GetEacFile(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.Path.ValueString())
+	if err != nil {
+		// The file was deregistered from the space outside of Terraform.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read EaC file, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setEacFileStatus(ctx, file, &data)...)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEacEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TorqueEacEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All arguments require replacement, there is nothing to update in Torque.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEacEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TorqueEacEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeregisterEacFile(data.SpaceName.ValueString(), data.RepositoryName.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deregister EaC file, got error: %s", err))
+		return
+	}
+}
+
+func (r *TorqueEacEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The path of the file may contain slashes, it is everything after the repository name.
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <space_name>/<repository_name>/<path>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[2])...)
+}
+
+// setEacFileStatus sets the synced environment, status and errors Torque reports for a registered EaC file.
+func setEacFileStatus(ctx context.Context, file *client.EacFile, data *TorqueEacEnvironmentResourceModel) diag.Diagnostics {
+	messages := []string{}
+	for _, fileError := range file.Errors {
+		messages = append(messages, fileError.Message)
+	}
+	var diags diag.Diagnostics
+	data.EnvironmentId = types.StringValue(file.EnvironmentId)
+	data.Status = types.StringValue(file.Status)
+	data.Errors, diags = types.ListValueFrom(ctx, types.StringType, messages)
+	return diags
+}
@@ -5,16 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,11 +25,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qualitorque/terraform-provider-torque/client"
+	"gopkg.in/yaml.v3"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TorqueEnvironmentResource{}
 var _ resource.ResourceWithImportState = &TorqueEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &TorqueEnvironmentResource{}
 
 func NewTorqueEnvironmentResource() resource.Resource {
 	return &TorqueEnvironmentResource{}
@@ -49,6 +54,12 @@ type BlueprintSourceModel struct {
 	Commit         *string `tfsdk:"commit"`
 }
 
+type BlueprintGitSourceModel struct {
+	Url  types.String `tfsdk:"url"`
+	Ref  types.String `tfsdk:"ref"`
+	Path types.String `tfsdk:"path"`
+}
+
 type WorkflowModel struct {
 	Name            types.String    `tfsdk:"name"`
 	Schedules       []ScheduleModel `tfsdk:"schedules"`
@@ -61,21 +72,27 @@ type ScheduleModel struct {
 }
 
 type TorqueEnvironmentResourceModel struct {
-	EnvironmentName  types.String          `tfsdk:"environment_name"`
-	BlueprintName    types.String          `tfsdk:"blueprint_name"`
-	Space            types.String          `tfsdk:"space"`
-	Id               types.String          `tfsdk:"id"`
-	OwnerEmail       types.String          `tfsdk:"owner_email"`
-	Description      types.String          `tfsdk:"description"`
-	Inputs           types.Map             `tfsdk:"inputs"`
-	Tags             types.Map             `tfsdk:"tags"`
-	Collaborators    *CollaboratorsModel   `tfsdk:"collaborators"`
-	Automation       types.Bool            `tfsdk:"automation"`
-	ForceDestroy     types.Bool            `tfsdk:"force_destroy"`
-	ScheduledEndTime types.String          `tfsdk:"scheduled_end_time"`
-	Duration         types.String          `tfsdk:"duration"`
-	BlueprintSource  *BlueprintSourceModel `tfsdk:"blueprint_source"`
-	Workflows        []WorkflowModel       `tfsdk:"workflows"`
+	EnvironmentName    types.String             `tfsdk:"environment_name"`
+	BlueprintName      types.String             `tfsdk:"blueprint_name"`
+	Space              types.String             `tfsdk:"space"`
+	Id                 types.String             `tfsdk:"id"`
+	OwnerEmail         types.String             `tfsdk:"owner_email"`
+	Description        types.String             `tfsdk:"description"`
+	Inputs             types.Map                `tfsdk:"inputs"`
+	Tags               types.Map                `tfsdk:"tags"`
+	Labels             []keyValuePairModel      `tfsdk:"labels"`
+	Collaborators      *CollaboratorsModel      `tfsdk:"collaborators"`
+	Automation         types.Bool               `tfsdk:"automation"`
+	ForceDestroy       types.Bool               `tfsdk:"force_destroy"`
+	ScheduledEndTime   types.String             `tfsdk:"scheduled_end_time"`
+	Duration           types.String             `tfsdk:"duration"`
+	BlueprintSource    *BlueprintSourceModel    `tfsdk:"blueprint_source"`
+	BlueprintYaml      types.String             `tfsdk:"blueprint_yaml"`
+	BlueprintGitSource *BlueprintGitSourceModel `tfsdk:"blueprint_git_source"`
+	Workflows          []WorkflowModel          `tfsdk:"workflows"`
+	AutoUpdate         types.Bool               `tfsdk:"auto_update"`
+	HasUpdates         types.Bool               `tfsdk:"has_updates"`
+	OutdatedGrains     types.List               `tfsdk:"outdated_grains"`
 }
 
 func (r *TorqueEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,16 +103,20 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Warning: This terraform resource is still in Beta. Use with caution. Issues may be reported in the provider's GitHub repository.
 
-		Launches a new Torque Environment from an existing blueprint.
+		Launches a new Torque Environment from an existing blueprint, or from a blueprint that is provided inline or fetched from a git repository.
 		
 		### Supported Updates:
 		- Environment name
 		- Collaborators
+		- Owner email - Transfers the ownership of the environment to another user.
+		- Tags
+		- Labels
 		- Force destroy - Whether the environment should be force terminated upon failure to terminate it.,
+		- Blueprint updates - When auto_update is set, outdated grains are updated to the latest commit of their source.
 		
 		### Limitations:
 		- Environment duration cannot be extended.
-		- Environment resource state is not refreshed with actual environment if it drifted
+		- Environment resource state is not refreshed with actual environment if it drifted, except for owner, labels and blueprint updates
 		- Terminated environment will be removed when running terraform destroy, but other values of the environment concrete state might cause terraform destroy to fail`,
 
 		Attributes: map[string]schema.Attribute{
@@ -125,14 +146,11 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"inputs": schema.MapAttribute{
-				MarkdownDescription: "Dictionary of key-value string pairs that will be used as values for the blueprint inputs. In case a value is not provided the input default value will be used. If a default value is not set, a validation error will be thrown upon launch. For example: { 'region': 'eu-west-1', 'application version': '1.0.8' }",
+				MarkdownDescription: "Dictionary of key-value string pairs that will be used as values for the blueprint inputs. Inputs are validated against the blueprint definition during plan: unknown input names, missing required inputs and values outside the input's possible values are rejected. Inputs of blueprints launched from a specific branch or commit, or from `blueprint_git_source`, are validated by Torque upon launch. In case a value is not provided when the environment is launched, the input default value will be used and set in the plan. Changing a configured input value will re-launch the environment, while removing an input from the configuration keeps the value the environment was launched with. For example: { 'region': 'eu-west-1', 'application version': '1.0.8' }",
 				ElementType:         types.StringType,
 				Required:            false,
-				Computed:            false,
+				Computed:            true,
 				Optional:            true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The new environment description that will be presented in the Torque following the launch of the environment.",
@@ -141,13 +159,26 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 				Optional:            true,
 			},
 			"tags": schema.MapAttribute{
-				MarkdownDescription: "Environment blueprint tags /// Dictionary of key-value string pairs that will be used to tag deployed resources in the environment. In case a configured tag value is not provided the tag default value will be used. Note that tags that were configured in the account and space level will be set regardless of this field. For example: { 'activity_type': 'demo'}",
+				MarkdownDescription: "Environment blueprint tags /// Dictionary of key-value string pairs that will be used to tag deployed resources in the environment. In case a configured tag value is not provided the tag default value will be used. Note that tags that were configured in the account and space level will be set regardless of this field. Changing tags updates the environment in place. For example: { 'activity_type': 'demo'}",
 				ElementType:         types.StringType,
 				Required:            false,
 				Computed:            false,
 				Optional:            true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifier.RequiresReplace(),
+			},
+			"labels": schema.SetNestedAttribute{
+				MarkdownDescription: "Set of labels to attach to the environment. Labels can be added or removed without re-launching the environment. Do not use together with torque_environment_label_association for the same environment.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Label key",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Label value",
+							Required:            true,
+						},
+					},
 				},
 			},
 			"collaborators": schema.ObjectAttribute{
@@ -189,6 +220,46 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 					},
 				},
 			},
+			"blueprint_yaml": schema.StringAttribute{
+				MarkdownDescription: "Inline blueprint YAML to launch the environment from, instead of a blueprint that is onboarded to the space. The blueprint_name is used as the name of the launched blueprint. Inputs are validated against the inputs defined in the YAML during plan.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("blueprint_source"),
+						path.MatchRoot("blueprint_git_source"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"blueprint_git_source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Git repository to fetch the blueprint from, for repositories that are not onboarded to the space. For example, to launch preview environments from a pull request branch. The blueprint is only fetched by Torque upon launch, so its inputs are not validated during plan and the defaults of inputs that are not set are not shown in the plan.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("blueprint_source"),
+						path.MatchRoot("blueprint_yaml"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "Clone URL of the git repository",
+						Required:            true,
+					},
+					"ref": schema.StringAttribute{
+						MarkdownDescription: "Branch, tag or commit id to fetch the blueprint from. If omitted, the default branch of the repository is used.",
+						Optional:            true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path of the blueprint YAML file in the repository. If omitted, the blueprint is looked up by blueprint_name in the repository's blueprints folder.",
+						Optional:            true,
+					},
+				},
+			},
 			"space": schema.StringAttribute{
 				MarkdownDescription: "The space where this environment will be launched",
 				Required:            false,
@@ -225,6 +296,28 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 				Optional:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"auto_update": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the environment grains should be updated to the latest commit of their source during apply, when blueprint updates are detected.",
+				Required:            false,
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"has_updates": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the source of any of the environment grains was updated since the environment was launched or last updated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outdated_grains": schema.ListAttribute{
+				MarkdownDescription: "Names of the environment grains that are not deployed from the latest commit of their source.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Id of the environment",
 				Required:            false,
@@ -232,12 +325,12 @@ func (r *TorqueEnvironmentResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            true,
 			},
 			"owner_email": schema.StringAttribute{
-				MarkdownDescription: "The email of the user that should be set as the owner of the new environment. if omitted the current user will be used.",
+				MarkdownDescription: "The email of the user that should be set as the owner of the new environment. if omitted the current user will be used. Changing the owner email transfers the ownership of the environment without re-launching it.",
 				Required:            false,
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"workflows": schema.ListNestedAttribute{
@@ -382,8 +475,37 @@ func (r *TorqueEnvironmentResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
-	body, err := r.client.CreateEnvironment(data.Space.ValueString(), data.BlueprintName.ValueString(), data.EnvironmentName.ValueString(), data.Duration.ValueString(), data.Description.ValueString(),
-		inputs, data.OwnerEmail.ValueString(), data.Automation.ValueBool(), tags, collaborators, data.ScheduledEndTime.ValueString(), blueprint_source, workflows)
+	var body []byte
+	var err error
+	if !data.BlueprintYaml.IsNull() || data.BlueprintGitSource != nil {
+		environment := client.EnvironmentFromSourceRequest{
+			EnvironmentRequest: client.EnvironmentRequest{
+				BlueprintName:    data.BlueprintName.ValueString(),
+				EnvironmentName:  data.EnvironmentName.ValueString(),
+				Description:      data.Description.ValueString(),
+				Duration:         data.Duration.ValueString(),
+				Inputs:           inputs,
+				OwnerEmail:       data.OwnerEmail.ValueString(),
+				Automation:       data.Automation.ValueBool(),
+				Tags:             tags,
+				Collaborators:    collaborators,
+				ScheduledEndTime: data.ScheduledEndTime.ValueString(),
+				Workflows:        workflows,
+			},
+			BlueprintYaml: data.BlueprintYaml.ValueString(),
+		}
+		if data.BlueprintGitSource != nil {
+			environment.Source = &client.EnvironmentGitSource{
+				RepositoryUrl: data.BlueprintGitSource.Url.ValueString(),
+				Ref:           data.BlueprintGitSource.Ref.ValueString(),
+				Path:          data.BlueprintGitSource.Path.ValueString(),
+			}
+		}
+		body, err = r.client.CreateEnvironmentFromSource(data.Space.ValueString(), environment)
+	} else {
+		body, err = r.client.CreateEnvironment(data.Space.ValueString(), data.BlueprintName.ValueString(), data.EnvironmentName.ValueString(), data.Duration.ValueString(), data.Description.ValueString(),
+			inputs, data.OwnerEmail.ValueString(), data.Automation.ValueBool(), tags, collaborators, data.ScheduledEndTime.ValueString(), blueprint_source, workflows)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Environment, got error: %s", err))
 		return
@@ -401,6 +523,31 @@ func (r *TorqueEnvironmentResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	data.Id = types.StringValue(id)
+	if data.Inputs.IsUnknown() {
+		var diags diag.Diagnostics
+		data.Inputs, diags = types.MapValueFrom(ctx, types.StringType, inputs)
+		resp.Diagnostics.Append(diags...)
+	}
+	data.HasUpdates = types.BoolValue(false)
+	data.OutdatedGrains = types.ListValueMust(types.StringType, []attr.Value{})
+	if data.OwnerEmail.IsUnknown() {
+		// The environment is owned by the current user, which is resolved by Torque.
+		data.OwnerEmail = types.StringNull()
+		environment_data, _, err := r.client.GetEnvironmentDetails(data.Space.ValueString(), id)
+		if err == nil {
+			data.OwnerEmail = types.StringValue(environment_data.Owner.OwnerEmail)
+		}
+	}
+
+	if len(data.Labels) > 0 {
+		err = r.client.UpdateEnvironmentLabels(id, data.Space.ValueString(), labelsDiff(data.Labels, nil), nil)
+		if err != nil {
+			// The environment was already launched, keep it in the state so it is not orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add labels to Environment '%s', got error: %s", id, err))
+			return
+		}
+	}
 
 	tflog.Trace(ctx, "Resource Created Successful!")
 
@@ -409,57 +556,58 @@ func (r *TorqueEnvironmentResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *TorqueEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// var data TorqueEnvironmentResourceModel
-	// var state TorqueEnvironmentResourceModel
-
-	// resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
-
-	// environment_data, _, err := r.client.GetEnvironmentDetails(state.Space.ValueString(), state.Id.ValueString())
-	// if err != nil {
-	// 	resp.Diagnostics.AddError(
-	// 		"Unable to Read Torque environment",
-	// 		err.Error(),
-	// 	)
-	// 	return
-	// }
-	// data.EnvironmentName = types.StringValue(environment_data.Details.Definition.Metadata.Name)
-	// data.BlueprintName = types.StringValue(environment_data.Details.Definition.Metadata.BlueprintName)
-	// data.Space = types.StringValue(environment_data.Details.Definition.Metadata.SpaceName)
-	// data.Id = types.StringValue(environment_data.Details.Id)
-	// data.OwnerEmail = types.StringValue(environment_data.Owner.OwnerEmail)
-	// data.Description = state.Description
-	// inputs := make(map[string]types.String)
-	// for _, input := range environment_data.Details.Definition.Inputs {
-	// 	inputs[input.Name] = types.StringValue(input.Value)
-	// }
-	// data.Inputs, _ = types.MapValueFrom(ctx, types.StringType, inputs)
-
-	// tags := make(map[string]types.String)
-	// for _, tag := range environment_data.Details.Definition.Tags {
-	// 	tags[tag.Name] = types.StringValue(tag.Value)
-	// }
-	// data.Tags, _ = types.MapValueFrom(ctx, types.StringType, tags)
-
-	// data.Collaborators = nil
-	// data.Automation = types.BoolValue(environment_data.IsEAC)
-	// data.ScheduledEndTime = types.StringValue("")
-	// data.Duration = types.StringValue("")
-	// data.BlueprintSource = nil
-	// data.Workflows = nil
-
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	var data TorqueEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment_data, _, err := r.client.GetEnvironmentDetails(data.Space.ValueString(), data.Id.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Torque environment",
+			err.Error(),
+		)
+		return
+	}
+	// An ended environment can not be changed anymore, removing it from the state launches it again.
+	const Inactive = "inactive"
+	if environment_data.Details.State.CurrentState == Inactive || strings.Contains(strings.ToLower(environment_data.Details.ComputedStatus), "ended") {
+		tflog.Warn(ctx, fmt.Sprintf("Environment %s has ended, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Emails are compared case insensitively, so the configured casing does not show up as drift.
+	if !strings.EqualFold(data.OwnerEmail.ValueString(), environment_data.Owner.OwnerEmail) {
+		data.OwnerEmail = types.StringValue(environment_data.Owner.OwnerEmail)
+	}
+	outdated_grains := outdatedGrains(environment_data)
+	data.HasUpdates = types.BoolValue(len(outdated_grains) > 0)
+	var diags diag.Diagnostics
+	data.OutdatedGrains, diags = types.ListValueFrom(ctx, types.StringType, outdated_grains)
+	resp.Diagnostics.Append(diags...)
+	// Labels are refreshed only when managed by this resource, so labels associated by
+	// torque_environment_label_association are not reported as drift.
+	if data.Labels != nil {
+		data.Labels = []keyValuePairModel{}
+		for _, label := range environment_data.Details.Definition.Labels {
+			data.Labels = append(data.Labels, keyValuePairModel{
+				Key:   types.StringValue(label.Key),
+				Value: types.StringValue(label.Value),
+			})
+		}
+	}
 
 	// Save updated data into Terraform state.
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TorqueEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -490,14 +638,18 @@ func (r *TorqueEnvironmentResource) Update(ctx context.Context, req resource.Upd
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if plan.Collaborators.AllSpaceMembers != state.Collaborators.AllSpaceMembers || !reflect.DeepEqual(plan.Collaborators.CollaboratorsEmails, state.Collaborators.CollaboratorsEmails) {
+	if !reflect.DeepEqual(plan.Collaborators, state.Collaborators) {
 		collaborators_emails := []string{}
-		if !plan.Collaborators.CollaboratorsEmails.IsNull() {
-			for _, email := range plan.Collaborators.CollaboratorsEmails.Elements() {
-				collaborators_emails = append(collaborators_emails, strings.Trim(email.String(), "\""))
+		all_space_members := false
+		if plan.Collaborators != nil {
+			if !plan.Collaborators.CollaboratorsEmails.IsNull() {
+				for _, email := range plan.Collaborators.CollaboratorsEmails.Elements() {
+					collaborators_emails = append(collaborators_emails, strings.Trim(email.String(), "\""))
+				}
 			}
+			all_space_members = plan.Collaborators.AllSpaceMembers.ValueBool()
 		}
-		err := r.client.UpdateEnvironmentCollaborators(state.Space.ValueString(), state.Id.ValueString(), collaborators_emails, plan.Collaborators.AllSpaceMembers.ValueBool())
+		err := r.client.UpdateEnvironmentCollaborators(state.Space.ValueString(), state.Id.ValueString(), collaborators_emails, all_space_members)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Environment update failed",
@@ -507,6 +659,73 @@ func (r *TorqueEnvironmentResource) Update(ctx context.Context, req resource.Upd
 			return
 		}
 	}
+	if !plan.OwnerEmail.IsUnknown() && !strings.EqualFold(plan.OwnerEmail.ValueString(), state.OwnerEmail.ValueString()) {
+		err := r.client.UpdateEnvironmentOwner(state.Space.ValueString(), state.Id.ValueString(), plan.OwnerEmail.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Environment update failed",
+				fmt.Sprintf("Failed to transfer environment ownership from '%s' to '%s': %s",
+					state.OwnerEmail.ValueString(), plan.OwnerEmail.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+	if plan.OwnerEmail.IsUnknown() {
+		plan.OwnerEmail = state.OwnerEmail
+	}
+	if !plan.Tags.Equal(state.Tags) {
+		tags := make(map[string]string)
+		if !plan.Tags.IsNull() {
+			resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		err := r.client.UpdateEnvironmentTags(state.Space.ValueString(), state.Id.ValueString(), tags)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Environment update failed",
+				fmt.Sprintf("Failed to update environment tags: %s", err.Error()),
+			)
+			return
+		}
+	}
+	added_labels := labelsDiff(plan.Labels, state.Labels)
+	removed_labels := labelsDiff(state.Labels, plan.Labels)
+	if len(added_labels) > 0 || len(removed_labels) > 0 {
+		err := r.client.UpdateEnvironmentLabels(state.Id.ValueString(), state.Space.ValueString(), added_labels, removed_labels)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Environment update failed",
+				fmt.Sprintf("Failed to update environment labels: %s", err.Error()),
+			)
+			return
+		}
+	}
+	if plan.AutoUpdate.ValueBool() && state.HasUpdates.ValueBool() {
+		grains := []string{}
+		resp.Diagnostics.Append(state.OutdatedGrains.ElementsAs(ctx, &grains, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.client.UpdateEnvironmentGrains(state.Space.ValueString(), state.Id.ValueString(), grains)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Environment update failed",
+				fmt.Sprintf("Failed to update environment grains %s to the latest commit: %s", strings.Join(grains, ", "), err.Error()),
+			)
+			return
+		}
+	}
+	if plan.HasUpdates.IsUnknown() {
+		plan.HasUpdates = types.BoolValue(state.HasUpdates.ValueBool())
+	}
+	if plan.OutdatedGrains.IsUnknown() {
+		plan.OutdatedGrains = state.OutdatedGrains
+		if plan.OutdatedGrains.IsNull() {
+			plan.OutdatedGrains = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+	}
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -537,6 +756,242 @@ func (r *TorqueEnvironmentResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// ModifyPlan validates the configured inputs against the blueprint definition and fills the plan of a
+// new environment with the default values of inputs that were not set. Inputs that are not configured
+// keep the value the environment was launched with, so the environment is only re-launched when a
+// configured input changes, which is decided here rather than by a plan modifier.
+func (r *TorqueEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the environment is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TorqueEnvironmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("inputs"), &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *TorqueEnvironmentResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &TorqueEnvironmentResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// Auto update is applied during apply, so the outdated grains are planned to be up to date.
+	if state != nil && plan.AutoUpdate.ValueBool() && state.HasUpdates.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("has_updates"), types.BoolValue(false))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("outdated_grains"), types.ListValueMust(types.StringType, []attr.Value{}))...)
+	}
+	if config.IsUnknown() {
+		if state != nil {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("inputs"))
+		}
+		return
+	}
+
+	// The planned inputs are the configured values, with the values the environment was launched with
+	// or, when it is launched, the blueprint defaults of the inputs that are not configured.
+	inputs := make(map[string]attr.Value)
+	for key, value := range config.Elements() {
+		inputs[key] = value
+	}
+	if state != nil && !state.Inputs.IsNull() && !state.Inputs.IsUnknown() {
+		for key, value := range state.Inputs.Elements() {
+			if _, ok := inputs[key]; !ok {
+				inputs[key] = value
+			}
+		}
+	}
+
+	if r.canValidateInputs(plan) {
+		var blueprint *client.Blueprint
+		if !plan.BlueprintYaml.IsNull() {
+			var err error
+			blueprint, err = blueprintFromYaml(plan.BlueprintName.ValueString(), plan.BlueprintYaml.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("blueprint_yaml"),
+					"Invalid Blueprint YAML",
+					fmt.Sprintf("Failed to parse the blueprint inputs: %s", err.Error()),
+				)
+				return
+			}
+		} else if r.client != nil {
+			space := plan.Space.ValueString()
+			if plan.Space.IsNull() {
+				space = r.client.Space
+			}
+			var err error
+			blueprint, err = r.client.GetBlueprint(space, plan.BlueprintName.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddWarning(
+					"Unable to validate environment inputs",
+					fmt.Sprintf("Failed to get blueprint '%s' in space '%s', inputs will be validated by Torque upon launch: %s", plan.BlueprintName.ValueString(), space, err.Error()),
+				)
+			}
+		}
+		if blueprint != nil {
+			validateEnvironmentInputs(blueprint, config, inputs, &resp.Diagnostics)
+			for _, input := range blueprint.Inputs {
+				if _, ok := inputs[input.Name]; !ok && state == nil && hasDefaultValue(input) {
+					inputs[input.Name] = types.StringValue(input.DefaultValue)
+				}
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := types.MapNull(types.StringType)
+	if len(inputs) > 0 || !config.IsNull() {
+		var diags diag.Diagnostics
+		planned, diags = types.MapValue(types.StringType, inputs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("inputs"), planned)...)
+
+	if state != nil && configuredInputsChanged(config, state.Inputs) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("inputs"))
+	}
+}
+
+// configuredInputsChanged reports whether a configured input differs from the value the environment was launched with.
+func configuredInputsChanged(config types.Map, launched types.Map) bool {
+	prior := launched.Elements()
+	for key, value := range config.Elements() {
+		if launched_value, ok := prior[key]; !ok || !value.Equal(launched_value) {
+			return true
+		}
+	}
+	return false
+}
+
+// canValidateInputs reports whether the blueprint the environment is launched from can be resolved
+// during plan. Blueprints launched from a specific branch or commit may define different inputs than
+// the ones registered in the space, and blueprints fetched from a git repository are only resolved
+// upon launch, so they are left for Torque to validate.
+func (r *TorqueEnvironmentResource) canValidateInputs(plan TorqueEnvironmentResourceModel) bool {
+	if plan.BlueprintName.IsUnknown() || plan.Space.IsUnknown() || plan.BlueprintYaml.IsUnknown() {
+		return false
+	}
+	if plan.BlueprintSource != nil && (plan.BlueprintSource.Branch != nil || plan.BlueprintSource.Commit != nil) {
+		return false
+	}
+	if plan.BlueprintGitSource != nil {
+		return false
+	}
+	return true
+}
+
+func validateEnvironmentInputs(blueprint *client.Blueprint, config types.Map, inputs map[string]attr.Value, diags *diag.Diagnostics) {
+	definitions := make(map[string]client.Input)
+	for _, input := range blueprint.Inputs {
+		definitions[input.Name] = input
+	}
+	for key, value := range config.Elements() {
+		definition, ok := definitions[key]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("inputs").AtMapKey(key),
+				"Unknown Blueprint Input",
+				fmt.Sprintf("Blueprint '%s' has no input named '%s'.", blueprint.Name, key),
+			)
+			continue
+		}
+		input_value, ok := value.(types.String)
+		if !ok || input_value.IsUnknown() || input_value.IsNull() || len(definition.PossibleValues) == 0 {
+			continue
+		}
+		if !slices.Contains(definition.PossibleValues, input_value.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("inputs").AtMapKey(key),
+				"Invalid Blueprint Input Value",
+				fmt.Sprintf("Value '%s' is not allowed for input '%s' of blueprint '%s'. Possible values are: %s.",
+					input_value.ValueString(), key, blueprint.Name, strings.Join(definition.PossibleValues, ", ")),
+			)
+		}
+	}
+	for _, definition := range blueprint.Inputs {
+		if _, ok := inputs[definition.Name]; !ok && !hasDefaultValue(definition) {
+			diags.AddAttributeError(
+				path.Root("inputs"),
+				"Missing Blueprint Input",
+				fmt.Sprintf("Input '%s' of blueprint '%s' has no default value and must be provided.", definition.Name, blueprint.Name),
+			)
+		}
+	}
+}
+
+// outdatedGrains returns the names of the environment grains that are not deployed from the latest commit of their source.
+func outdatedGrains(environment *client.Environment) []string {
+	grains := []string{}
+	for _, grain := range environment.Details.State.Grains {
+		for _, source := range grain.Sources {
+			if source.Commit != "" && !source.IsLastCommit {
+				grains = append(grains, grain.Name)
+				break
+			}
+		}
+	}
+	return grains
+}
+
+// labelsDiff returns the labels that are not found in the other labels.
+func labelsDiff(labels []keyValuePairModel, other []keyValuePairModel) []client.KeyValuePair {
+	diff := []client.KeyValuePair{}
+	for _, label := range labels {
+		if !labelExists(label, other) {
+			diff = append(diff, client.KeyValuePair{
+				Key:   label.Key.ValueString(),
+				Value: label.Value.ValueString(),
+			})
+		}
+	}
+	return diff
+}
+
+// blueprintFromYaml reads the inputs defined in an inline blueprint YAML, so they can be validated
+// the same way as the inputs of a blueprint that is onboarded to the space.
+func blueprintFromYaml(name string, content string) (*client.Blueprint, error) {
+	var definition struct {
+		Inputs map[string]map[string]any `yaml:"inputs"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &definition); err != nil {
+		return nil, err
+	}
+	blueprint := &client.Blueprint{Name: name, Inputs: []client.Input{}}
+	for input_name, properties := range definition.Inputs {
+		input := client.Input{Name: input_name}
+		if default_value, ok := properties["default"]; ok {
+			input.HasDefaultValue = true
+			if default_value != nil {
+				input.DefaultValue = fmt.Sprint(default_value)
+			}
+		}
+		if allowed_values, ok := properties["allowed-values"].([]any); ok {
+			for _, value := range allowed_values {
+				input.PossibleValues = append(input.PossibleValues, fmt.Sprint(value))
+			}
+		}
+		blueprint.Inputs = append(blueprint.Inputs, input)
+	}
+	return blueprint, nil
+}
+
+func hasDefaultValue(input client.Input) bool {
+	return input.HasDefaultValue || input.DefaultValue != ""
+}
+
 func (r *TorqueEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
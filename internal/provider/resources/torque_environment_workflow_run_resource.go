@@ -0,0 +1,277 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/qualitorque/terraform-provider-torque/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TorqueEnvironmentWorkflowRunResource{}
+var _ resource.ResourceWithImportState = &TorqueEnvironmentWorkflowRunResource{}
+
+func NewTorqueEnvironmentWorkflowRunResource() resource.Resource {
+	return &TorqueEnvironmentWorkflowRunResource{}
+}
+
+// TorqueEnvironmentWorkflowRunResource defines the resource implementation.
+type TorqueEnvironmentWorkflowRunResource struct {
+	client *client.Client
+}
+
+// TorqueEnvironmentWorkflowRunResourceModel describes the resource data model.
+type TorqueEnvironmentWorkflowRunResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	SpaceName     types.String `tfsdk:"space_name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	WorkflowName  types.String `tfsdk:"workflow_name"`
+	Inputs        types.Map    `tfsdk:"inputs"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	TimeOut       types.Int32  `tfsdk:"timeout"`
+	Status        types.String `tfsdk:"status"`
+	Outputs       types.Map    `tfsdk:"outputs"`
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "torque_environment_workflow_run"
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Runs an environment or environment resource scoped workflow (for example, a scheduled power-off or a DB snapshot) on an existing Torque environment and waits for the run to complete.
+
+		The workflow is run again whenever one of the arguments or the ` + "`triggers`" + ` map changes. Destroying this resource does not revert the actions taken by the workflow.
+
+		Can be imported using ` + "`<space_name>/<run_id>`" + `.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the workflow run",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_name": schema.StringAttribute{
+				MarkdownDescription: "The space of the environment the workflow will run on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment the workflow will run on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workflow_name": schema.StringAttribute{
+				MarkdownDescription: "Name of an existing and enabled workflow in the space to run",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inputs": schema.MapAttribute{
+				MarkdownDescription: "Dictionary of key-value string pairs that will be used as values for the workflow inputs",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the workflow again",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "Time in minutes to wait for the workflow run to complete. Default is 30 minutes.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(30),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the workflow run",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outputs": schema.MapAttribute{
+				MarkdownDescription: "Outputs of the workflow run",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TorqueEnvironmentWorkflowRunResourceModel
+	const Interval = 10 * time.Second
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputs := make(map[string]string)
+	resp.Diagnostics.Append(data.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.RunEnvironmentWorkflow(data.SpaceName.ValueString(), data.EnvironmentId.ValueString(), data.WorkflowName.ValueString(), inputs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run workflow '%s' on environment '%s', got error: %s", data.WorkflowName.ValueString(), data.EnvironmentId.ValueString(), err))
+		return
+	}
+	data.Id = types.StringValue(id)
+	// Save the run id right away so a failed or timed out run is tracked in the state and run again on the next apply.
+	data.Status = types.StringNull()
+	data.Outputs = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	timeout := time.Duration(data.TimeOut.ValueInt32()) * time.Minute
+	run, err := r.client.WaitForEnvironmentWorkflowRun(data.SpaceName.ValueString(), id, timeout, Interval)
+	if err != nil {
+		resp.Diagnostics.AddError("Workflow Run Error", fmt.Sprintf("Failed waiting for workflow '%s' run '%s' to complete: %s", data.WorkflowName.ValueString(), id, err))
+		return
+	}
+	resp.Diagnostics.Append(r.setWorkflowRunData(ctx, &data, run)...)
+	if client.IsWorkflowRunFailed(run) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Workflow Run Failed", fmt.Sprintf("Workflow '%s' run '%s' ended with status '%s': %s", data.WorkflowName.ValueString(), id, run.Details.ComputedStatus, client.WorkflowRunErrors(run)))
+		return
+	}
+
+	tflog.Trace(ctx, "Resource Created Successful!")
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TorqueEnvironmentWorkflowRunResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, _, err := r.client.GetEnvironmentDetails(data.SpaceName.ValueString(), data.Id.ValueString())
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		if strings.Contains(err.Error(), "status: 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow run '%s', got error: %s", data.Id.ValueString(), err))
+		return
+	}
+	// Imported workflow runs only have the space and run id in the state yet.
+	if data.EnvironmentId.IsNull() {
+		data.EnvironmentId = types.StringValue(run.EnvironmentId)
+		data.WorkflowName = types.StringValue(run.Details.Definition.Metadata.BlueprintName)
+		if len(run.Details.Definition.Inputs) > 0 {
+			inputs := make(map[string]string)
+			for _, input := range run.Details.Definition.Inputs {
+				inputs[input.Name] = input.Value
+			}
+			var diags diag.Diagnostics
+			data.Inputs, diags = types.MapValueFrom(ctx, types.StringType, inputs)
+			resp.Diagnostics.Append(diags...)
+		}
+		data.TimeOut = types.Int32Value(30)
+	}
+	resp.Diagnostics.Append(r.setWorkflowRunData(ctx, &data, run)...)
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TorqueEnvironmentWorkflowRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout can be updated in place, it is used on the next run.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A workflow run cannot be undone, the resource is only removed from the state.
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	space_name, run_id, found := strings.Cut(req.ID, "/")
+	if !found || space_name == "" || run_id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <space_name>/<run_id>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_name"), space_name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), run_id)...)
+}
+
+func (r *TorqueEnvironmentWorkflowRunResource) setWorkflowRunData(ctx context.Context, data *TorqueEnvironmentWorkflowRunResourceModel, run *client.Environment) diag.Diagnostics {
+	outputs := make(map[string]string)
+	for _, output := range run.Details.State.Outputs {
+		outputs[output.Name] = output.Value
+	}
+	var diags diag.Diagnostics
+	data.Status = types.StringValue(run.Details.ComputedStatus)
+	data.Outputs, diags = types.MapValueFrom(ctx, types.StringType, outputs)
+	return diags
+}
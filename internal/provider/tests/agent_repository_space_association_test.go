@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	agent_repository_branch     = "main"
+	agent_repository_name       = "terraform-provider-torque"
+	agent_repository_credential = "TerraformOnPremGitCreds"
+	agent_repository_agent      = "demo-prod"
+)
+
+func TestGithubEnterpriseRepositorySpaceAssociation(t *testing.T) {
+	resource.Test(t, agentRepositoryTestCase(t, "torque_github_enterprise_repository_space_association",
+		"https://github-on-prem.example.com/QualiTorque/terraform-provider-torque"))
+}
+
+func TestBitbucketServerRepositorySpaceAssociation(t *testing.T) {
+	resource.Test(t, agentRepositoryTestCase(t, "torque_bitbucket_server_repository_space_association",
+		"https://bitbucket-on-prem.example.com/scm/torque/terraform-provider-torque.git"))
+}
+
+func TestAgentRepositorySpaceAssociationAgentsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_github_enterprise_repository_space_association" "repository" {
+					space_name      = "%s"
+					repository_name = "%s"
+					repository_url  = "https://github-on-prem.example.com/QualiTorque/terraform-provider-torque"
+					branch          = "%s"
+					credential_name = "%s"
+					use_all_agents  = true
+					agents          = ["%s"]
+				}
+				`, fullSpaceName, agent_repository_name, agent_repository_branch, agent_repository_credential, agent_repository_agent),
+				ExpectError: regexp.MustCompile("Cannot specify agents when use_all_agents is true"),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_bitbucket_server_repository_space_association" "repository" {
+					space_name      = "%s"
+					repository_name = "%s"
+					repository_url  = "https://bitbucket-on-prem.example.com/scm/torque/terraform-provider-torque.git"
+					branch          = "%s"
+					credential_name = "%s"
+					use_all_agents  = false
+				}
+				`, fullSpaceName, agent_repository_name, agent_repository_branch, agent_repository_credential),
+				ExpectError: regexp.MustCompile("Agents list must contain at least one element"),
+			},
+		},
+	})
+}
+
+func agentRepositoryTestCase(t *testing.T, resource_type string, repository_url string) resource.TestCase {
+	resource_name := resource_type + ".repository"
+	return resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "%s" "repository" {
+					space_name      = "%s"
+					repository_name = "%s"
+					repository_url  = "%s"
+					branch          = "%s"
+					credential_name = "%s"
+				}
+				`, resource_type, fullSpaceName, agent_repository_name, repository_url, agent_repository_branch, agent_repository_credential),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_name, "space_name", fullSpaceName),
+					resource.TestCheckResourceAttr(resource_name, "repository_name", agent_repository_name),
+					resource.TestCheckResourceAttr(resource_name, "repository_url", repository_url),
+					resource.TestCheckResourceAttr(resource_name, "branch", agent_repository_branch),
+					resource.TestCheckResourceAttr(resource_name, "credential_name", agent_repository_credential),
+					resource.TestCheckResourceAttr(resource_name, "use_all_agents", "true"),
+					resource.TestCheckNoResourceAttr(resource_name, "agents"),
+					resource.TestCheckResourceAttrSet(resource_name, "status"),
+					resource.TestCheckResourceAttrSet(resource_name, "discovered_blueprints"),
+				),
+			},
+			// Update to a specific agent and resync
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "%s" "repository" {
+					space_name      = "%s"
+					repository_name = "%s"
+					repository_url  = "%s"
+					branch          = "%s"
+					credential_name = "%s"
+					use_all_agents  = false
+					agents          = ["%s"]
+					resync_trigger  = "1"
+				}
+				`, resource_type, fullSpaceName, agent_repository_name, repository_url, agent_repository_branch, agent_repository_credential, agent_repository_agent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_name, "use_all_agents", "false"),
+					resource.TestCheckResourceAttr(resource_name, "agents.#", "1"),
+					resource.TestCheckResourceAttr(resource_name, "agents.0", agent_repository_agent),
+					resource.TestCheckResourceAttr(resource_name, "resync_trigger", "1"),
+					resource.TestCheckResourceAttrSet(resource_name, "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resource_name,
+				ImportState:                          true,
+				ImportStateId:                        fullSpaceName + "/" + agent_repository_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "repository_name",
+				ImportStateVerifyIgnore:              []string{"resync_trigger"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTorqueAgentResource(t *testing.T) {
+	agent_name := "agent-" + index
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_agent" "agent" {
+					name        = "%s"
+					description = "%s"
+				}
+				`, agent_name, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_agent.agent", "name", agent_name),
+					resource.TestCheckResourceAttr("torque_agent.agent", "type", "K8S"),
+					resource.TestCheckResourceAttr("torque_agent.agent", "description", description),
+					resource.TestCheckResourceAttrSet("torque_agent.agent", "token"),
+					resource.TestCheckResourceAttrSet("torque_agent.agent", "install_manifest"),
+					resource.TestCheckResourceAttrSet("torque_agent.agent", "status"),
+				),
+			},
+			// Data source testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_agent" "agent" {
+					name        = "%s"
+					description = "%s"
+				}
+
+				data "torque_agents" "agents" {
+					type       = "K8S"
+					depends_on = [torque_agent.agent]
+				}
+				`, agent_name, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.torque_agents.agents", "agents.*", map[string]string{
+						"name": agent_name,
+						"type": "K8S",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "torque_agent.agent",
+				ImportState:                          true,
+				ImportStateId:                        agent_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"token", "install_manifest"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestApprovalRequestsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_approval_requests" "requests" {
+						space_name = "%s"
+						status     = "Pending"
+					}
+				`, space_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.torque_approval_requests.requests", "space_name", space_name),
+					resource.TestCheckResourceAttr("data.torque_approval_requests.requests", "status", "Pending"),
+					resource.TestCheckResourceAttrSet("data.torque_approval_requests.requests", "requests.#"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_approval_requests" "requests" {
+						space_name       = "%s"
+						approval_channel = "non-existing-channel"
+					}
+				`, space_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.torque_approval_requests.requests", "requests.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -5,11 +5,14 @@ package tests
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/qualitorque/terraform-provider-torque/client"
 )
 
 func TestAWSCostTargetResource(t *testing.T) {
@@ -27,6 +30,7 @@ func TestAWSCostTargetResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCostTargetsNotFound(aws_cost_target_name, new_aws_cost_target_name),
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig + fmt.Sprintf(`
@@ -56,6 +60,13 @@ func TestAWSCostTargetResource(t *testing.T) {
 					resource.TestCheckResourceAttr("torque_aws_cost_target.test", "external_id", new_aws_cost_target_external_id),
 				),
 			},
+			{
+				ResourceName:                         "torque_aws_cost_target.test",
+				ImportState:                          true,
+				ImportStateId:                        new_aws_cost_target_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
 			{
 				Config: providerConfig + fmt.Sprintf(`
 				resource "torque_aws_cost_target" "test" {
@@ -69,3 +80,28 @@ func TestAWSCostTargetResource(t *testing.T) {
 		},
 	})
 }
+
+func testCostTargetsNotFound(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		host := os.Getenv("TORQUE_HOST")
+		space := os.Getenv("TORQUE_SPACE")
+		token := os.Getenv("TORQUE_TOKEN")
+
+		c, err := client.NewClient(&host, &space, &token)
+		if err != nil {
+			return err
+		}
+		targets, err := c.ListCostTargets()
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			for _, name := range names {
+				if target.Name == name {
+					return fmt.Errorf("expected cost target '%s' to be deleted", name)
+				}
+			}
+		}
+		return nil
+	}
+}
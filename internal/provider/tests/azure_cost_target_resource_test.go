@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAzureCostTargetResource(t *testing.T) {
+	azure_cost_target_name := fmt.Sprintf("azure_cost_target_%s", index)
+	new_azure_cost_target_name := fmt.Sprintf("new_azure_cost_target_%s", index)
+	const (
+		tenant_id         = "00000000-0000-0000-0000-000000000000"
+		subscription_id   = "11111111-1111-1111-1111-111111111111"
+		client_id         = "22222222-2222-2222-2222-222222222222"
+		client_secret     = "client_secret"
+		storage_account   = "costexports"
+		storage_container = "exports"
+		export_directory  = "torque"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_azure_cost_target" "test" {
+					name              = "%s"
+					tenant_id         = "%s"
+					subscription_id   = "%s"
+					client_id         = "%s"
+					client_secret     = "%s"
+					storage_account   = "%s"
+					storage_container = "%s"
+				}
+				`, azure_cost_target_name, tenant_id, subscription_id, client_id, client_secret, storage_account, storage_container),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "name", azure_cost_target_name),
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "tenant_id", tenant_id),
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "subscription_id", subscription_id),
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "storage_account", storage_account),
+					resource.TestCheckNoResourceAttr("torque_azure_cost_target.test", "export_directory"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_azure_cost_target" "test" {
+					name              = "%s"
+					tenant_id         = "%s"
+					subscription_id   = "%s"
+					client_id         = "%s"
+					client_secret     = "%s"
+					storage_account   = "%s"
+					storage_container = "%s"
+					export_directory  = "%s"
+				}
+				`, new_azure_cost_target_name, tenant_id, subscription_id, client_id, client_secret, storage_account, storage_container, export_directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "name", new_azure_cost_target_name),
+					resource.TestCheckResourceAttr("torque_azure_cost_target.test", "export_directory", export_directory),
+				),
+			},
+			{
+				ResourceName:                         "torque_azure_cost_target.test",
+				ImportState:                          true,
+				ImportStateId:                        new_azure_cost_target_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"client_secret"},
+			},
+		},
+	})
+}
@@ -153,6 +153,122 @@ func TestCatalogItemDurationConflicts(t *testing.T) {
 	})
 }
 
+func TestCatalogItemInputsWithoutSettings(t *testing.T) {
+	spaceName := os.Getenv("TORQUE_SPACE")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_catalog_item" "catalog_item" {
+					space_name      = "%s"
+					blueprint_name  = "doesnt matter"
+					repository_name = "doesnt matter"
+					inputs = [
+						{
+							name = "region"
+						}
+					]
+				}
+				`, spaceName),
+				ExpectError: regexp.MustCompile("Invalid Input Settings"),
+			},
+		},
+	})
+}
+
+func TestCatalogItemInputs(t *testing.T) {
+	spaceName := os.Getenv("TORQUE_SPACE")
+	var unique_blueprint_name = blueprint_name + "_" + index
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with input settings
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_catalog_item" "catalog_item" {
+					space_name      = "%s"
+					blueprint_name  = "%s"
+					repository_name = "%s"
+					inputs = [
+						{
+							name          = "region"
+							default_value = "eu-west-1"
+							locked        = true
+						}
+					]
+				}
+				`, spaceName, unique_blueprint_name, repository_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_catalog_item.catalog_item", "inputs.#", "1"),
+					resource.TestCheckResourceAttr("torque_catalog_item.catalog_item", "inputs.0.name", "region"),
+					resource.TestCheckResourceAttr("torque_catalog_item.catalog_item", "inputs.0.default_value", "eu-west-1"),
+					resource.TestCheckResourceAttr("torque_catalog_item.catalog_item", "inputs.0.locked", "true"),
+					resource.TestCheckResourceAttr("torque_catalog_item.catalog_item", "inputs.0.hidden", "false"),
+				),
+			},
+			// Read the input settings back from Torque without changes
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_catalog_item" "catalog_item" {
+					space_name      = "%s"
+					blueprint_name  = "%s"
+					repository_name = "%s"
+					inputs = [
+						{
+							name          = "region"
+							default_value = "eu-west-1"
+							locked        = true
+						}
+					]
+				}
+				`, spaceName, unique_blueprint_name, repository_name),
+				PlanOnly: true,
+			},
+			// Clear the input settings
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_catalog_item" "catalog_item" {
+					space_name      = "%s"
+					blueprint_name  = "%s"
+					repository_name = "%s"
+				}
+				`, spaceName, unique_blueprint_name, repository_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("torque_catalog_item.catalog_item", "inputs"),
+					testCatalogItemInputsCleared(unique_blueprint_name),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testCatalogItemInputsCleared(blueprint string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		host := os.Getenv("TORQUE_HOST")
+		space := os.Getenv("TORQUE_SPACE")
+		token := os.Getenv("TORQUE_TOKEN")
+
+		c, err := client.NewClient(&host, &space, &token)
+		if err != nil {
+			return err
+		}
+		bp, err := c.GetBlueprint(space, blueprint)
+		if err != nil {
+			return err
+		}
+		for _, input := range bp.Inputs {
+			if input.DefaultOverride != nil || input.Locked || input.Hidden {
+				return fmt.Errorf("expected settings of input '%s' to be cleared", input.Name)
+			}
+		}
+		return nil
+	}
+}
+
 func testBlueprintPublished(blueprint string) resource.TestCheckFunc {
 	return checkBlueprintPublishedCondition(true, blueprint)
 }
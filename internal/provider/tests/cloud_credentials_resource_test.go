@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestTorqueCloudCredentials(t *testing.T) {
+	cloud_credentials_name := "azure_credentials_" + index
+	const (
+		tenant_id     = "00000000-0000-0000-0000-000000000000"
+		client_id     = "11111111-1111-1111-1111-111111111111"
+		client_secret = "secret"
+		new_tenant_id = "22222222-2222-2222-2222-222222222222"
+		new_client_id = "33333333-3333-3333-3333-333333333333"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_cloud_credentials" "azure" {
+					name        = "%s"
+					description = "%s"
+					azure = {
+						tenant_id     = "%s"
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+				}
+				`, cloud_credentials_name, description, tenant_id, client_id, client_secret),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "name", cloud_credentials_name),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "description", description),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "azure.tenant_id", tenant_id),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "azure.client_id", client_id),
+					resource.TestCheckNoResourceAttr("torque_cloud_credentials.azure", "azure.client_secret"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_cloud_credentials" "azure" {
+					name                = "%s"
+					description         = "%s"
+					allowed_space_names = ["%s"]
+					secrets_version     = 2
+					azure = {
+						tenant_id     = "%s"
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+				}
+				`, cloud_credentials_name, new_description, space_name, tenant_id, client_id, client_secret),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "description", new_description),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "allowed_space_names.#", "1"),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "allowed_space_names.0", space_name),
+				),
+			},
+			// Rotating the service principal updates the credentials in place
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_cloud_credentials" "azure" {
+					name                = "%s"
+					description         = "%s"
+					allowed_space_names = ["%s"]
+					secrets_version     = 3
+					azure = {
+						tenant_id     = "%s"
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+				}
+				`, cloud_credentials_name, new_description, space_name, new_tenant_id, new_client_id, client_secret),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("torque_cloud_credentials.azure", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "azure.tenant_id", new_tenant_id),
+					resource.TestCheckResourceAttr("torque_cloud_credentials.azure", "azure.client_id", new_client_id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "torque_cloud_credentials.azure",
+				ImportState:                          true,
+				ImportStateId:                        cloud_credentials_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"secrets_version"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEacEnvironmentResource(t *testing.T) {
+	const eac_path = "environments/eac.yaml"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_eac_environment" "eac" {
+					space_name      = "%s"
+					repository_name = "%s"
+					path            = "%s"
+				}
+				`, fullSpaceName, repo_name, eac_path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_eac_environment.eac", "space_name", fullSpaceName),
+					resource.TestCheckResourceAttr("torque_eac_environment.eac", "repository_name", repo_name),
+					resource.TestCheckResourceAttr("torque_eac_environment.eac", "path", eac_path),
+					resource.TestCheckResourceAttrSet("torque_eac_environment.eac", "environment_id"),
+					resource.TestCheckResourceAttrSet("torque_eac_environment.eac", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "torque_eac_environment.eac",
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s/%s/%s", fullSpaceName, repo_name, eac_path),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "path",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEnvironmentActions(t *testing.T) {
+	const (
+		environment_blueprint_name = "S3 Bucket"
+		agent                      = "demo-prod"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Extend and reconcile a newly launched environment
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "%s"
+					environment_name = "actions-%s"
+					duration         = "PT2H"
+					inputs = {
+						agent = "%s"
+					}
+				}
+
+				action "torque_environment_extend" "extend" {
+					config {
+						space_name     = "%s"
+						environment_id = torque_environment.env.id
+						duration       = "PT1H"
+					}
+				}
+
+				action "torque_environment_reconcile" "reconcile" {
+					config {
+						space_name     = "%s"
+						environment_id = torque_environment.env.id
+					}
+				}
+
+				resource "terraform_data" "actions" {
+					lifecycle {
+						action_trigger {
+							events  = [after_create]
+							actions = [action.torque_environment_extend.extend, action.torque_environment_reconcile.reconcile]
+						}
+					}
+					depends_on = [torque_environment.env]
+				}
+				`, space_name, environment_blueprint_name, index, agent, space_name, space_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("torque_environment.env", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestEnvironmentActionsNonExistentEnvironment(t *testing.T) {
+	const environment_id = "non-existent-environment"
+	actions := []struct {
+		name   string
+		config string
+		error  string
+	}{
+		{"torque_environment_extend", `duration = "PT1H"`, "Unable to extend environment"},
+		{"torque_environment_terminate", `force = true`, "Unable to read environment"},
+		{"torque_environment_release", ``, "Unable to release environment"},
+		{"torque_environment_reconcile", ``, "Unable to reconcile environment"},
+		{"torque_environment_run_workflow", fmt.Sprintf(`workflow_name = "%s"`, workflow_name), "Unable to run workflow"},
+	}
+	for _, a := range actions {
+		t.Run(a.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+						action "%s" "action" {
+							config {
+								space_name     = "%s"
+								environment_id = "%s"
+								%s
+							}
+						}
+
+						resource "terraform_data" "trigger" {
+							lifecycle {
+								action_trigger {
+									events  = [after_create]
+									actions = [action.%s.action]
+								}
+							}
+						}
+						`, a.name, space_name, environment_id, a.config, a.name),
+						ExpectError: regexp.MustCompile(a.error),
+					},
+				},
+			})
+		})
+	}
+}
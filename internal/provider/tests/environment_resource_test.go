@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestEnvironmentResourceInputsValidation(t *testing.T) {
+	const (
+		environment_blueprint_name = "S3 Bucket"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown input name
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "%s"
+					environment_name = "inputs-validation"
+					inputs = {
+						agent  = "demo-prod"
+						regoin = "eu-west-1"
+					}
+				}
+				`, space_name, environment_blueprint_name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown Blueprint Input"),
+			},
+			// Missing required input
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "%s"
+					environment_name = "inputs-validation"
+				}
+				`, space_name, environment_blueprint_name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Blueprint Input"),
+			},
+			// Inputs of an inline blueprint
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "inline-blueprint"
+					environment_name = "inputs-validation"
+					blueprint_yaml   = <<-EOT
+						spec_version: 2
+						inputs:
+						  size:
+						    type: string
+						    allowed-values: ["small", "large"]
+					EOT
+					inputs = {
+						size = "medium"
+					}
+				}
+				`, space_name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Blueprint Input Value"),
+			},
+		},
+	})
+}
+
+func TestEnvironmentResourceInputsDefaults(t *testing.T) {
+	const (
+		environment_blueprint_name = "S3 Bucket"
+		environment_agent          = "demo-prod"
+	)
+	config := providerConfig + fmt.Sprintf(`
+	resource "torque_environment" "env" {
+		space            = "%s"
+		blueprint_name   = "%s"
+		environment_name = "inputs-defaults"
+		duration         = "PT1H"
+		inputs = {
+			agent = "%s"
+		}
+	}
+	`, space_name, environment_blueprint_name, environment_agent)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Launch with the blueprint defaults of the inputs that are not configured
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_environment.env", "inputs.agent", environment_agent),
+					resource.TestCheckResourceAttrSet("torque_environment.env", "id"),
+				),
+			},
+			// The planned defaults do not re-launch the environment when the configuration is unchanged
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Removing the inputs from the configuration keeps the launched values without a re-launch
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "%s"
+					environment_name = "inputs-defaults"
+					duration         = "PT1H"
+				}
+				`, space_name, environment_blueprint_name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("torque_environment.env", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestEnvironmentWorkflowRunResource(t *testing.T) {
+	const (
+		environment_blueprint_name = "S3 Bucket"
+		agent                      = "demo-prod"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Run the workflow on a newly launched environment
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment" "env" {
+					space            = "%s"
+					blueprint_name   = "%s"
+					environment_name = "workflow-run-%s"
+					inputs = {
+						agent = "%s"
+					}
+				}
+
+				resource "torque_environment_workflow_run" "run" {
+					space_name     = "%s"
+					environment_id = torque_environment.env.id
+					workflow_name  = "%s"
+					timeout        = 20
+				}
+				`, space_name, environment_blueprint_name, index, agent, space_name, workflow_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("torque_environment_workflow_run.run", "id"),
+					resource.TestCheckResourceAttrPair("torque_environment_workflow_run.run", "environment_id", "torque_environment.env", "id"),
+					resource.TestCheckResourceAttr("torque_environment_workflow_run.run", "workflow_name", workflow_name),
+					resource.TestCheckResourceAttr("torque_environment_workflow_run.run", "timeout", "20"),
+					resource.TestCheckResourceAttrSet("torque_environment_workflow_run.run", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "torque_environment_workflow_run.run",
+				ImportState:       true,
+				ImportStateIdFunc: testWorkflowRunImportId("torque_environment_workflow_run.run"),
+				ImportStateVerify: true,
+				// The timeout is only used while the workflow runs, it is not reported by Torque.
+				ImportStateVerifyIgnore: []string{"timeout"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestEnvironmentWorkflowRunNonExistentEnvironment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_environment_workflow_run" "run" {
+					space_name     = "%s"
+					environment_id = "non-existent-environment"
+					workflow_name  = "%s"
+				}
+				`, space_name, workflow_name),
+				ExpectError: regexp.MustCompile("Unable to run workflow"),
+			},
+		},
+	})
+}
+
+func testWorkflowRunImportId(resource_name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resource_name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resource_name)
+		}
+		return rs.Primary.Attributes["space_name"] + "/" + rs.Primary.ID, nil
+	}
+}
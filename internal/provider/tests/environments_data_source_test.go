@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEnvironmentsDataSource(t *testing.T) {
+	const (
+		id                    = "cMX1RkaWj6gm"
+		name                  = "S3-TF-Provider-Test"
+		environment_blueprint = "S3 Bucket"
+		owner_email           = "amir.r@quali.com"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_environments" "envs" {
+						space_name     = "%s"
+						blueprint_name = "%s"
+						owner_email    = "%s"
+						status         = "active"
+					}
+				`, space_name, environment_blueprint, owner_email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.torque_environments.envs", "environments.*", map[string]string{
+						"id":             id,
+						"name":           name,
+						"blueprint_name": environment_blueprint,
+						"owner_email":    owner_email,
+					}),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_environments" "envs" {
+						space_name     = "%s"
+						blueprint_name = "%s"
+						status         = "ended"
+						labels = {
+							"non-existing-label" = "value"
+						}
+					}
+				`, space_name, environment_blueprint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.torque_environments.envs", "environments.#", "0"),
+				),
+			},
+		},
+	})
+}
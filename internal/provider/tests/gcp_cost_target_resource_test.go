@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGcpCostTargetResource(t *testing.T) {
+	gcp_cost_target_name := fmt.Sprintf("gcp_cost_target_%s", index)
+	new_gcp_cost_target_name := fmt.Sprintf("new_gcp_cost_target_%s", index)
+	const (
+		project_id          = "torque-billing"
+		billing_dataset     = "billing_export"
+		billing_table       = "gcp_billing_export_v1_000000_000000_000000"
+		new_billing_table   = "gcp_billing_export_v1_111111_111111_111111"
+		service_account_key = "{\"type\": \"service_account\"}"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_gcp_cost_target" "test" {
+					name                = "%s"
+					project_id          = "%s"
+					billing_dataset     = "%s"
+					billing_table       = "%s"
+					service_account_key = %q
+				}
+				`, gcp_cost_target_name, project_id, billing_dataset, billing_table, service_account_key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "name", gcp_cost_target_name),
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "project_id", project_id),
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "billing_dataset", billing_dataset),
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "billing_table", billing_table),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_gcp_cost_target" "test" {
+					name                = "%s"
+					project_id          = "%s"
+					billing_dataset     = "%s"
+					billing_table       = "%s"
+					service_account_key = %q
+				}
+				`, new_gcp_cost_target_name, project_id, billing_dataset, new_billing_table, service_account_key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "name", new_gcp_cost_target_name),
+					resource.TestCheckResourceAttr("torque_gcp_cost_target.test", "billing_table", new_billing_table),
+				),
+			},
+			{
+				ResourceName:                         "torque_gcp_cost_target.test",
+				ImportState:                          true,
+				ImportStateId:                        new_gcp_cost_target_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"service_account_key"},
+			},
+		},
+	})
+}
+
+func TestGcpCostTargetImportOtherCloud(t *testing.T) {
+	aws_cost_target_name := fmt.Sprintf("gcp_import_aws_cost_target_%s", index)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_aws_cost_target" "test" {
+					name        = "%s"
+					role_arn    = "arn:aws:iam::111111111111:role/torque-cost"
+					external_id = "external"
+				}
+				`, aws_cost_target_name),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_aws_cost_target" "test" {
+					name        = "%s"
+					role_arn    = "arn:aws:iam::111111111111:role/torque-cost"
+					external_id = "external"
+				}
+
+				resource "torque_gcp_cost_target" "imported" {
+					name                = "%s"
+					project_id          = "project"
+					billing_dataset     = "dataset"
+					billing_table       = "table"
+					service_account_key = "key"
+				}
+				`, aws_cost_target_name, aws_cost_target_name),
+				ResourceName:  "torque_gcp_cost_target.imported",
+				ImportState:   true,
+				ImportStateId: aws_cost_target_name,
+				ExpectError:   regexp.MustCompile("Cannot import non-existent remote object"),
+			},
+		},
+	})
+}
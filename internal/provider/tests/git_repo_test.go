@@ -41,9 +41,61 @@ func TestGitRepoWithCreds(t *testing.T) {
 					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "branch", branch),
 					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "repository_url", repository_url),
 					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "repository_type", repository_type),
+					resource.TestCheckResourceAttrSet("torque_repository_space_association.repository_with_credentials", "status"),
 				),
 			},
 			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_repository_space_association" "repository_with_credentials" {
+					space_name      = "%s"
+					repository_url  = "%s"
+					repository_type = "%s"
+					branch          = "%s"
+					repository_name = "%s"
+					credential_name = "%s"
+					resync_trigger  = "1"
+				}
+				`, fullSpaceName, repository_url, repository_type, branch, repo_name, credential_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "resync_trigger", "1"),
+					resource.TestCheckResourceAttrSet("torque_repository_space_association.repository_with_credentials", "last_synced_commit"),
+				),
+			},
+			// Discovery settings testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_repository_space_association" "repository_with_credentials" {
+					space_name        = "%s"
+					repository_url    = "%s"
+					repository_type   = "%s"
+					branch            = "%s"
+					repository_name   = "%s"
+					credential_name   = "%s"
+					resync_trigger    = "1"
+					auto_register_eac = true
+					discovery_folders = [
+						{
+							path    = "blueprints"
+							include = ["*.yaml"]
+							exclude = ["drafts/**"]
+						},
+						{
+							path   = "workflows"
+							type   = "workflow"
+							branch = "%s"
+						}
+					]
+				}
+				`, fullSpaceName, repository_url, repository_type, branch, repo_name, credential_name, branch),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "auto_register_eac", "true"),
+					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "discovery_folders.#", "2"),
+					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "discovery_folders.0.type", "blueprint"),
+					resource.TestCheckResourceAttr("torque_repository_space_association.repository_with_credentials", "discovery_folders.1.type", "workflow"),
+					resource.TestCheckResourceAttrSet("torque_repository_space_association.repository_with_credentials", "discovered_blueprints"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -4,6 +4,7 @@
 package tests
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -45,3 +46,23 @@ func TestIntrospectionResource(t *testing.T) {
 		},
 	})
 }
+
+func TestIntrospectionResourceImportInvalidId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "torque_introspection_resource" "test" {
+					display_name = "My Resource"
+				}
+				`,
+				ResourceName:  "torque_introspection_resource.test",
+				ImportState:   true,
+				ImportStateId: "My Resource",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSpaceCostDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_space_cost" "cost" {
+						space_name = "%s"
+						from       = "2024-01-01"
+						to         = "2024-01-31"
+					}
+				`, space_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.torque_space_cost.cost", "total"),
+					resource.TestCheckResourceAttrSet("data.torque_space_cost.cost", "currency"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_space_cost" "cost" {
+						space_name = "%s"
+						from       = "01/01/2024"
+						to         = "2024-01-31"
+					}
+				`, space_name),
+				ExpectError: regexp.MustCompile("must be a date in YYYY-MM-DD format"),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					data "torque_space_cost" "cost" {
+						space_name = "%s"
+						from       = "2024-01-31"
+						to         = "2024-01-01"
+					}
+				`, space_name),
+				ExpectError: regexp.MustCompile("Invalid Time Range"),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSpaceRepositoryMovedFromLegacy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with the legacy resource
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_repository_space_association" "repository" {
+					space_name      = "%s"
+					repository_url  = "%s"
+					repository_type = "%s"
+					branch          = "%s"
+					repository_name = "%s"
+					credential_name = "%s"
+				}
+				`, fullSpaceName, repository_url, repository_type, branch, repo_name, credential_name),
+			},
+			// Move to the unified resource without recreating the repository
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				moved {
+					from = torque_repository_space_association.repository
+					to   = torque_space_repository.repository
+				}
+
+				resource "torque_space_repository" "repository" {
+					space_name      = "%s"
+					repository_url  = "%s"
+					type            = "%s"
+					branch          = "%s"
+					repository_name = "%s"
+					credential_name = "%s"
+				}
+				`, fullSpaceName, repository_url, repository_type, branch, repo_name, credential_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_space_repository.repository", "type", repository_type),
+					resource.TestCheckResourceAttr("torque_space_repository.repository", "repository_name", repo_name),
+					resource.TestCheckResourceAttrSet("torque_space_repository.repository", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "torque_space_repository.repository",
+				ImportState:                          true,
+				ImportStateId:                        fullSpaceName + "/" + repo_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "repository_name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
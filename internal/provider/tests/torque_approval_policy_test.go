@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestTorqueApprovalPolicy(t *testing.T) {
+	const (
+		approval_channel = "policy_approval_channel"
+		approval_policy  = "approval_policy"
+		approver         = "terraformtester@quali.com"
+	)
+
+	var unique_channel_name = approval_channel + "_" + index
+	var unique_name = approval_policy + "_" + index
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_email_approval_channel" "channel" {
+					name      = "%s"
+					approvers = ["%s"]
+				}
+
+				resource "torque_approval_policy" "policy" {
+					name             = "%s"
+					description      = "%s"
+					approval_channel = torque_email_approval_channel.channel.name
+					space_name       = "%s"
+				}
+				`, unique_channel_name, approver, unique_name, description, fullSpaceName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(unique_name),
+					),
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("approval_channel"),
+						knownvalue.StringExact(unique_channel_name),
+					),
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("conditions"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_email_approval_channel" "channel" {
+					name      = "%s"
+					approvers = ["%s"]
+				}
+
+				resource "torque_approval_policy" "policy" {
+					name             = "%s"
+					description      = "%s"
+					approval_channel = torque_email_approval_channel.channel.name
+					space_name       = "%s"
+					conditions = {
+						duration_above = "P1D"
+						cost_above     = 100
+						inputs = [
+							{
+								name   = "instance_type"
+								values = ["m5.xlarge"]
+							}
+						]
+					}
+				}
+				`, unique_channel_name, approver, unique_name, new_description, fullSpaceName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact(new_description),
+					),
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("conditions").AtMapKey("duration_above"),
+						knownvalue.StringExact("P1D"),
+					),
+					statecheck.ExpectKnownValue(
+						"torque_approval_policy.policy",
+						tfjsonpath.New("conditions").AtMapKey("inputs").AtSliceIndex(0).AtMapKey("values").AtSliceIndex(0),
+						knownvalue.StringExact("m5.xlarge"),
+					),
+				},
+			},
+			{
+				ResourceName:                         "torque_approval_policy.policy",
+				ImportState:                          true,
+				ImportStateId:                        unique_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestTorqueApprovalPolicyEmptyConditions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "torque_approval_policy" "policy" {
+					name             = "doesnt matter"
+					approval_channel = "doesnt matter"
+					space_name       = "doesnt matter"
+					conditions       = {}
+				}
+				`,
+				ExpectError: regexp.MustCompile("Missing Approval Policy Condition"),
+			},
+		},
+	})
+}
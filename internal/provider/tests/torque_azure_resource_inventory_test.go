@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTorqueAzureResourceInventory(t *testing.T) {
+	azure_inventory_name := "azure_" + index
+	const (
+		tenant_id           = "00000000-0000-0000-0000-000000000000"
+		client_id           = "11111111-1111-1111-1111-111111111111"
+		client_secret       = "secret"
+		subscription_id     = "22222222-2222-2222-2222-222222222222"
+		new_subscription_id = "33333333-3333-3333-3333-333333333333"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_azure_resource_inventory" "azure" {
+					name             = "%s"
+					description      = "%s"
+					tenant_id        = "%s"
+					client_id        = "%s"
+					client_secret    = "%s"
+					subscription_ids = ["%s"]
+				}
+				`, azure_inventory_name, description, tenant_id, client_id, client_secret, subscription_id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "name", azure_inventory_name),
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "cloud_type", "azure"),
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "subscription_ids.0", subscription_id),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "torque_azure_resource_inventory" "azure" {
+					name             = "%s"
+					description      = "%s"
+					tenant_id        = "%s"
+					client_id        = "%s"
+					client_secret    = "%s"
+					subscription_ids = ["%s", "%s"]
+				}
+
+				data "torque_resource_inventory" "azure" {
+					cloud_type = "azure"
+					depends_on = [torque_azure_resource_inventory.azure]
+				}
+				`, azure_inventory_name, new_description, tenant_id, client_id, client_secret, subscription_id, new_subscription_id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "description", new_description),
+					resource.TestCheckResourceAttr("torque_azure_resource_inventory.azure", "subscription_ids.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.torque_resource_inventory.azure", "inventories.*", map[string]string{
+						"credentials": azure_inventory_name,
+						"cloud_type":  "azure",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "torque_azure_resource_inventory.azure",
+				ImportState:                          true,
+				ImportStateId:                        azure_inventory_name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"client_secret"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}